@@ -0,0 +1,47 @@
+// Package basics - Classic bit manipulation tricks
+package basics
+
+// PopCount returns the number of 1 bits in x (its "population count")
+// It uses Kernighan's trick: x & (x-1) clears the lowest set bit, so
+// the loop runs once per set bit rather than once per bit. In real code
+// prefer math/bits.OnesCount64, which compiles to a CPU instruction
+// Parameter: x - the value to inspect
+// Returns: the number of set bits, from 0 to 64
+func PopCount(x uint64) int {
+	count := 0
+	for x != 0 {
+		x &= x - 1 // Drop the lowest set bit
+		count++
+	}
+	return count
+}
+
+// IsPowerOfTwo reports whether x is 1, 2, 4, 8, ...
+// A power of two has exactly one bit set, so clearing its lowest set
+// bit leaves zero. Zero itself is not a power of two
+// Parameter: x - the value to check
+// Returns: true if x has exactly one bit set
+func IsPowerOfTwo(x uint64) bool {
+	return x != 0 && x&(x-1) == 0
+}
+
+// NextPowerOfTwo returns the smallest power of two that is >= x
+// It subtracts one, smears the highest set bit into every lower
+// position with shifts and ORs, then adds one back. Exact powers of two
+// are returned unchanged, and 0 gives 1
+// Parameter: x - the lower bound
+// Returns: the next power of two, or 0 if it would not fit in a uint64
+// (x > 1<<63)
+func NextPowerOfTwo(x uint64) uint64 {
+	if x <= 1 {
+		return 1
+	}
+	x--
+	x |= x >> 1
+	x |= x >> 2
+	x |= x >> 4
+	x |= x >> 8
+	x |= x >> 16
+	x |= x >> 32
+	return x + 1 // Wraps to 0 when every bit was set
+}
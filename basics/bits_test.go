@@ -0,0 +1,89 @@
+// Package basics - Tests for the bit manipulation helpers
+package basics
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+// TestPopCount verifies set bits are counted
+func TestPopCount(t *testing.T) {
+	tests := []struct {
+		input    uint64
+		expected int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 1},
+		{7, 3},
+		{0b1011_0001, 4},
+		{1 << 63, 1},
+		{math.MaxUint64, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%#x", tt.input), func(t *testing.T) {
+			if got := PopCount(tt.input); got != tt.expected {
+				t.Errorf("PopCount(%#x) = %d; want %d", tt.input, got, tt.expected)
+			}
+			// Cross-check against the standard library
+			if got := bits.OnesCount64(tt.input); got != tt.expected {
+				t.Errorf("bits.OnesCount64(%#x) = %d; want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestIsPowerOfTwo verifies single-bit values are detected
+func TestIsPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		input    uint64
+		expected bool
+	}{
+		{0, false},
+		{1, true},
+		{2, true},
+		{3, false},
+		{64, true},
+		{96, false},
+		{1 << 63, true},
+		{math.MaxUint64, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.input), func(t *testing.T) {
+			if got := IsPowerOfTwo(tt.input); got != tt.expected {
+				t.Errorf("IsPowerOfTwo(%d) = %v; want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNextPowerOfTwo verifies rounding up to a power of two
+func TestNextPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		input    uint64
+		expected uint64
+	}{
+		{0, 1},
+		{1, 1},
+		{2, 2},
+		{3, 4},
+		{5, 8},
+		{64, 64},
+		{65, 128},
+		{1<<62 + 1, 1 << 63},
+		{1 << 63, 1 << 63},
+		{1<<63 + 1, 0}, // Doesn't fit in 64 bits
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.input), func(t *testing.T) {
+			if got := NextPowerOfTwo(tt.input); got != tt.expected {
+				t.Errorf("NextPowerOfTwo(%d) = %d; want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
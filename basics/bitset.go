@@ -0,0 +1,79 @@
+// Package basics - A bit set built on the bitwise operators
+package basics
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// wordSize is the number of bits stored in each uint64 word
+const wordSize = 64
+
+// BitSet stores a set of non-negative integers as individual bits
+// Bit i lives in word i/64 at position i%64, so a million flags take
+// only 125 KB. It puts the operators from BitwiseOperations to work:
+// | sets a bit, &^ clears it, and & tests it
+type BitSet struct {
+	words []uint64
+	size  int // Number of addressable bits
+}
+
+// NewBitSet creates a bit set that can hold bits 0 to n-1 with all bits
+// cleared. Setting a higher bit grows it automatically
+// Parameter: n - the initial number of bits (negative is treated as 0)
+// Returns: a pointer to the new, empty BitSet
+func NewBitSet(n int) *BitSet {
+	n = max(n, 0)
+	return &BitSet{words: make([]uint64, (n+wordSize-1)/wordSize), size: n}
+}
+
+// Set turns bit i on, growing the set if i is beyond Len
+// It panics for negative i, just like indexing a slice would
+func (b *BitSet) Set(i int) {
+	if i < 0 {
+		panic(fmt.Sprintf("bitset: negative index %d", i))
+	}
+	if i >= b.size {
+		b.size = i + 1
+		for len(b.words) < (b.size+wordSize-1)/wordSize {
+			b.words = append(b.words, 0)
+		}
+	}
+	// OR with a mask that has only bit i%64 set
+	b.words[i/wordSize] |= 1 << (i % wordSize)
+}
+
+// Clear turns bit i off; bits outside the set are already off
+func (b *BitSet) Clear(i int) {
+	if i < 0 || i >= b.size {
+		return
+	}
+	// AND NOT (&^) clears the bits that are set in the mask
+	b.words[i/wordSize] &^= 1 << (i % wordSize)
+}
+
+// Test reports whether bit i is on
+// Bits outside the set, including negative ones, report false
+func (b *BitSet) Test(i int) bool {
+	if i < 0 || i >= b.size {
+		return false
+	}
+	// AND with the mask leaves a non-zero value only if bit i is set
+	return b.words[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// Count returns how many bits are on
+// math/bits.OnesCount64 counts a whole word at once, usually with a
+// single CPU instruction
+func (b *BitSet) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// Len returns the number of addressable bits, on or off
+func (b *BitSet) Len() int {
+	return b.size
+}
@@ -0,0 +1,71 @@
+// Package basics - Tests for the BitSet type
+package basics
+
+import "testing"
+
+// TestBitSetAcrossWords verifies bits on both sides of word boundaries
+func TestBitSetAcrossWords(t *testing.T) {
+	b := NewBitSet(200)
+	indexes := []int{0, 1, 63, 64, 65, 127, 128, 199}
+	for _, i := range indexes {
+		b.Set(i)
+	}
+
+	for _, i := range indexes {
+		if !b.Test(i) {
+			t.Errorf("Test(%d) = false after Set; want true", i)
+		}
+	}
+	for _, i := range []int{2, 62, 66, 126, 129, 198} {
+		if b.Test(i) {
+			t.Errorf("Test(%d) = true; want false (never set)", i)
+		}
+	}
+	if b.Count() != len(indexes) {
+		t.Errorf("Count() = %d; want %d", b.Count(), len(indexes))
+	}
+
+	// Clearing one bit must not disturb its neighbours
+	b.Clear(64)
+	if b.Test(64) || !b.Test(63) || !b.Test(65) {
+		t.Error("Clear(64) changed a neighbouring bit or left bit 64 set")
+	}
+	if b.Count() != len(indexes)-1 {
+		t.Errorf("Count() after Clear = %d; want %d", b.Count(), len(indexes)-1)
+	}
+}
+
+// TestBitSetGrowth verifies Set beyond Len grows the set
+func TestBitSetGrowth(t *testing.T) {
+	b := NewBitSet(0)
+	if b.Len() != 0 || b.Count() != 0 {
+		t.Errorf("NewBitSet(0) Len() = %d, Count() = %d; want 0, 0", b.Len(), b.Count())
+	}
+
+	b.Set(130)
+	if b.Len() != 131 {
+		t.Errorf("Len() after Set(130) = %d; want 131", b.Len())
+	}
+	if !b.Test(130) || b.Count() != 1 {
+		t.Errorf("Test(130) = %v, Count() = %d; want true, 1", b.Test(130), b.Count())
+	}
+}
+
+// TestBitSetOutOfRange verifies out-of-range reads and clears are safe
+func TestBitSetOutOfRange(t *testing.T) {
+	b := NewBitSet(10)
+	if b.Test(-1) || b.Test(10) || b.Test(1000) {
+		t.Error("Test() outside the set = true; want false")
+	}
+	b.Clear(1000) // No-op, must not panic or grow
+	if b.Len() != 10 {
+		t.Errorf("Len() after Clear(1000) = %d; want 10", b.Len())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Set(-1) did not panic")
+		}
+	}()
+	b.Set(-1)
+}
@@ -0,0 +1,95 @@
+// Package basics - Rounding to a number of decimal places
+package basics
+
+import (
+	"math"
+	"strconv"
+)
+
+// RoundMode selects how Round breaks ties and which way it rounds
+// Declaring constants with iota is Go's way of writing an enum
+type RoundMode int
+
+const (
+	// RoundHalfUp rounds to the nearest value, with halves rounding away
+	// from zero: 2.5 becomes 3 and -2.5 becomes -3
+	RoundHalfUp RoundMode = iota
+	// RoundHalfEven rounds to the nearest value, with halves rounding to
+	// the even neighbour: 2.5 becomes 2 and 3.5 becomes 4. Also called
+	// banker's rounding, because it doesn't bias sums upward
+	RoundHalfEven
+	// RoundFloor always rounds down, toward negative infinity
+	RoundFloor
+	// RoundCeil always rounds up, toward positive infinity
+	RoundCeil
+)
+
+// Round rounds value to the given number of decimal places using mode
+// Negative places round to the left of the decimal point, so places -2
+// rounds to the nearest hundred. Values are treated as the decimal
+// number they print as: 2.45 is really stored as 2.4500000000000001776,
+// but Round(2.45, 1, RoundHalfEven) still sees a tie and returns 2.4
+// Parameters:
+//   - value: the number to round
+//   - places: how many digits to keep after the decimal point
+//   - mode: the rounding rule to apply
+//
+// Returns: the rounded value; NaN and infinities are returned unchanged
+func Round(value float64, places int, mode RoundMode) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+
+	// Scale so the digit being rounded sits just left of the point. For
+	// negative places, divide by 10^-places instead of multiplying by
+	// 10^places: 1e-5 has no exact binary form, so 123456 * 1e-5 / 1e-5
+	// comes back as 99999.99999999999 rather than 100000, while 1e5 is
+	// exact
+	var scaled float64
+	if places >= 0 {
+		scale := math.Pow10(places)
+		if math.IsInf(scale, 0) {
+			// No float64 has digits that far right of the point
+			return value
+		}
+		scaled = value * scale
+	} else {
+		factor := math.Pow10(-places)
+		if math.IsInf(factor, 0) {
+			// places < -308: rounding to a position far beyond the
+			// largest float64 leaves nothing but a (signed) zero
+			return math.Copysign(0, value)
+		}
+		scaled = value / factor
+	}
+	if math.Abs(scaled) >= 1<<53 {
+		// From 2^53 up every float64 is a whole number, so the scaled
+		// value has no digits left to round; returning value also avoids
+		// the error of scaling back
+		return value
+	}
+
+	// Keep 15 significant digits (all that float64 guarantees) to drop
+	// the representation error, so 2.45 * 10 is exactly 24.5, not
+	// 24.500000000000004. Values of 1e15 and above already carry more
+	// than 15 real digits, so trimming them would change the value
+	if math.Abs(scaled) < 1e15 {
+		scaled, _ = strconv.ParseFloat(strconv.FormatFloat(scaled, 'g', 15, 64), 64)
+	}
+
+	var rounded float64
+	switch mode {
+	case RoundHalfEven:
+		rounded = math.RoundToEven(scaled)
+	case RoundFloor:
+		rounded = math.Floor(scaled)
+	case RoundCeil:
+		rounded = math.Ceil(scaled)
+	default: // RoundHalfUp
+		rounded = math.Round(scaled)
+	}
+	if places >= 0 {
+		return rounded / math.Pow10(places)
+	}
+	return rounded * math.Pow10(-places)
+}
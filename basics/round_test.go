@@ -0,0 +1,104 @@
+// Package basics - Tests for decimal rounding
+package basics
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestRound verifies every mode on ties and near-ties
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		expected [4]float64 // Indexed by RoundMode: half-up, half-even, floor, ceil
+	}{
+		{2.5, 0, [4]float64{3, 2, 2, 3}},
+		{3.5, 0, [4]float64{4, 4, 3, 4}},
+		{-2.5, 0, [4]float64{-3, -2, -3, -2}},
+		{2.4, 0, [4]float64{2, 2, 2, 3}},
+		{2.45, 1, [4]float64{2.5, 2.4, 2.4, 2.5}},
+		{2.55, 1, [4]float64{2.6, 2.6, 2.5, 2.6}},
+		{2.675, 2, [4]float64{2.68, 2.68, 2.67, 2.68}},
+		{1.005, 2, [4]float64{1.01, 1, 1, 1.01}},
+		{-1.125, 2, [4]float64{-1.13, -1.12, -1.13, -1.12}},
+		{2.3, 1, [4]float64{2.3, 2.3, 2.3, 2.3}}, // Already exact: floor must not give 2.2
+		{1250, -2, [4]float64{1300, 1200, 1200, 1300}},
+		{123456, -3, [4]float64{123000, 123000, 123000, 124000}},
+		{123456, -5, [4]float64{100000, 100000, 100000, 200000}},
+		{50000, -5, [4]float64{100000, 0, 0, 100000}}, // Exactly halfway
+		{-150000, -5, [4]float64{-200000, -200000, -200000, -100000}},
+		{0, 3, [4]float64{0, 0, 0, 0}},
+	}
+
+	modeNames := []string{"half-up", "half-even", "floor", "ceil"}
+
+	for _, tt := range tests {
+		for mode, want := range tt.expected {
+			name := modeNames[mode]
+			t.Run(fmt.Sprintf("%v/%d/%s", tt.value, tt.places, name), func(t *testing.T) {
+				if got := Round(tt.value, tt.places, RoundMode(mode)); got != want {
+					t.Errorf("Round(%v, %d, %s) = %v; want %v", tt.value, tt.places, name, got, want)
+				}
+			})
+		}
+	}
+}
+
+// TestRoundSpecialValues verifies NaN and infinities pass through
+func TestRoundSpecialValues(t *testing.T) {
+	if got := Round(math.NaN(), 2, RoundHalfUp); !math.IsNaN(got) {
+		t.Errorf("Round(NaN) = %v; want NaN", got)
+	}
+	if got := Round(math.Inf(1), 2, RoundFloor); !math.IsInf(got, 1) {
+		t.Errorf("Round(+Inf) = %v; want +Inf", got)
+	}
+	if got := Round(math.MaxFloat64, 2, RoundHalfUp); got != math.MaxFloat64 {
+		t.Errorf("Round(MaxFloat64, 2) = %v; want MaxFloat64", got)
+	}
+}
+
+// TestRoundLargeMagnitudes verifies values with more than 15 significant
+// digits keep their real digits instead of being trimmed
+func TestRoundLargeMagnitudes(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		expected float64
+	}{
+		{12345678901234.567, 2, 12345678901234.57},
+		{123456789012345678, 0, 123456789012345678},
+		{-123456789012345678, 0, -123456789012345678},
+		{1e20, 3, 1e20},
+	}
+
+	for _, tt := range tests {
+		if got := Round(tt.value, tt.places, RoundHalfUp); got != tt.expected {
+			t.Errorf("Round(%v, %d, RoundHalfUp) = %v; want %v", tt.value, tt.places, got, tt.expected)
+		}
+	}
+}
+
+// TestRoundExtremePlaces verifies places far beyond the float64 range
+// give zero (keeping the sign) or the value itself, never NaN
+func TestRoundExtremePlaces(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		expected float64
+	}{
+		{123, -400, 0},
+		{-123, -400, math.Copysign(0, -1)},
+		{123, 400, 123},
+		{0, 400, 0},
+		{1.5, 330, 1.5},
+	}
+
+	for _, tt := range tests {
+		got := Round(tt.value, tt.places, RoundHalfUp)
+		if got != tt.expected || math.Signbit(got) != math.Signbit(tt.expected) {
+			t.Errorf("Round(%v, %d, RoundHalfUp) = %v; want %v", tt.value, tt.places, got, tt.expected)
+		}
+	}
+}
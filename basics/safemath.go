@@ -0,0 +1,80 @@
+// Package basics - Overflow-checked integer arithmetic
+package basics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Sentinel errors for checked arithmetic
+// Compare against them with errors.Is, which also sees through the
+// ArithmeticError wrapper below
+var (
+	ErrOverflow       = errors.New("integer overflow")
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// ArithmeticError describes a failed checked operation
+// Carrying the operands makes the message useful on its own, and Unwrap
+// lets errors.Is match the sentinel underneath. errors.As extracts the
+// details when a caller needs them
+type ArithmeticError struct {
+	Op   string // "add", "mul" or "div"
+	A, B int    // The operands
+	Err  error  // ErrOverflow or ErrDivisionByZero
+}
+
+// Error formats the failed operation, e.g. "mul 4611686018427387904 2: integer overflow"
+func (e *ArithmeticError) Error() string {
+	return fmt.Sprintf("%s %d %d: %v", e.Op, e.A, e.B, e.Err)
+}
+
+// Unwrap returns the underlying sentinel error
+func (e *ArithmeticError) Unwrap() error {
+	return e.Err
+}
+
+// SafeAdd returns a + b, or an error if the sum doesn't fit in an int
+// Plain + silently wraps around: math.MaxInt + 1 becomes math.MinInt
+// Parameters: a, b - the numbers to add
+// Returns: the sum, or an *ArithmeticError wrapping ErrOverflow
+func SafeAdd(a, b int) (int, error) {
+	// Check before adding, since the overflowed result is already wrong
+	if (b > 0 && a > math.MaxInt-b) || (b < 0 && a < math.MinInt-b) {
+		return 0, &ArithmeticError{Op: "add", A: a, B: b, Err: ErrOverflow}
+	}
+	return a + b, nil
+}
+
+// SafeMul returns a * b, or an error if the product doesn't fit in an int
+// Parameters: a, b - the numbers to multiply
+// Returns: the product, or an *ArithmeticError wrapping ErrOverflow
+func SafeMul(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	product := a * b
+	// Dividing back recovers a only if nothing was lost. MinInt * -1 is
+	// the one case that fools the check, because MinInt / -1 also wraps
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, &ArithmeticError{Op: "mul", A: a, B: b, Err: ErrOverflow}
+	}
+	return product, nil
+}
+
+// SafeDiv returns a / b (truncated toward zero) without panicking
+// Integer division by zero panics at run time, and math.MinInt / -1
+// overflows, since +9223372036854775808 doesn't fit in an int64
+// Parameters: a - the dividend, b - the divisor
+// Returns: the quotient, or an *ArithmeticError wrapping
+// ErrDivisionByZero or ErrOverflow
+func SafeDiv(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "div", A: a, B: b, Err: ErrDivisionByZero}
+	}
+	if a == math.MinInt && b == -1 {
+		return 0, &ArithmeticError{Op: "div", A: a, B: b, Err: ErrOverflow}
+	}
+	return a / b, nil
+}
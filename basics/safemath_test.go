@@ -0,0 +1,69 @@
+// Package basics - Tests for overflow-checked arithmetic
+package basics
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestSafeArithmetic verifies results and errors at the int boundaries
+func TestSafeArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(a, b int) (int, error)
+		a, b     int
+		expected int
+		wantErr  error
+	}{
+		{"add normal", SafeAdd, 2, 3, 5, nil},
+		{"add to max", SafeAdd, math.MaxInt - 1, 1, math.MaxInt, nil},
+		{"add overflow", SafeAdd, math.MaxInt, 1, 0, ErrOverflow},
+		{"add to min", SafeAdd, math.MinInt + 1, -1, math.MinInt, nil},
+		{"add underflow", SafeAdd, math.MinInt, -1, 0, ErrOverflow},
+		{"add max and min", SafeAdd, math.MaxInt, math.MinInt, -1, nil},
+
+		{"mul normal", SafeMul, -4, 5, -20, nil},
+		{"mul by zero", SafeMul, math.MaxInt, 0, 0, nil},
+		{"mul max by one", SafeMul, math.MaxInt, 1, math.MaxInt, nil},
+		{"mul overflow", SafeMul, math.MaxInt/2 + 1, 2, 0, ErrOverflow},
+		{"mul underflow", SafeMul, math.MinInt/2 - 1, 2, 0, ErrOverflow},
+		{"mul min by minus one", SafeMul, math.MinInt, -1, 0, ErrOverflow},
+		{"mul minus one by min", SafeMul, -1, math.MinInt, 0, ErrOverflow},
+		{"mul min by one", SafeMul, math.MinInt, 1, math.MinInt, nil},
+
+		{"div normal", SafeDiv, 17, 5, 3, nil},
+		{"div truncates toward zero", SafeDiv, -17, 5, -3, nil},
+		{"div by zero", SafeDiv, 10, 0, 0, ErrDivisionByZero},
+		{"div min by minus one", SafeDiv, math.MinInt, -1, 0, ErrOverflow},
+		{"div min by one", SafeDiv, math.MinInt, 1, math.MinInt, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("(%d, %d) error = %v; want %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("(%d, %d) = %d; want %d", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestArithmeticError verifies the error carries the operation details
+func TestArithmeticError(t *testing.T) {
+	_, err := SafeDiv(7, 0)
+
+	var arithErr *ArithmeticError
+	if !errors.As(err, &arithErr) {
+		t.Fatalf("SafeDiv(7, 0) error = %T; want *ArithmeticError", err)
+	}
+	if arithErr.Op != "div" || arithErr.A != 7 || arithErr.B != 0 {
+		t.Errorf("ArithmeticError = %+v; want Op=div A=7 B=0", arithErr)
+	}
+	if want := "div 7 0: division by zero"; err.Error() != want {
+		t.Errorf("Error() = %q; want %q", err.Error(), want)
+	}
+}
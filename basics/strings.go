@@ -0,0 +1,98 @@
+// Package basics - String processing demonstration
+package basics
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// RunLengthEncode compresses a string by replacing runs of the same
+// character with the character followed by the run length
+// Example: "aaabcc" becomes "a3b1c2"
+// Parameter: s - the string to encode
+// Returns: the run-length encoded string
+func RunLengthEncode(s string) string {
+	// strings.Builder avoids creating a new string on every append
+	var builder strings.Builder
+
+	// Convert to runes so multi-byte characters are treated as one character
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		// Count how many times the current rune repeats
+		j := i
+		for j < len(runes) && runes[j] == runes[i] {
+			j++
+		}
+		builder.WriteRune(runes[i])
+		builder.WriteString(strconv.Itoa(j - i))
+		// Jump to the start of the next run
+		i = j
+	}
+
+	return builder.String()
+}
+
+// CompressionRatio reports how well run-length encoding compresses s
+// The ratio is the encoded length divided by the original length,
+// both measured in characters (runes)
+// A ratio below 1 means the encoding is smaller than the input, while
+// varied input with few repeats gives a ratio of 1 or more
+// Parameter: s - the string to measure
+// Returns: the compression ratio, or 0 for an empty string
+func CompressionRatio(s string) float64 {
+	originalLength := utf8.RuneCountInString(s)
+	// Avoid dividing by zero for empty input
+	if originalLength == 0 {
+		return 0
+	}
+
+	encodedLength := utf8.RuneCountInString(RunLengthEncode(s))
+	return float64(encodedLength) / float64(originalLength)
+}
+
+// NGrams returns every contiguous sequence of n characters (runes) in s
+// Example: NGrams("golang", 3) returns ["gol", "ola", "lan", "ang"]
+// Parameters:
+//   - s: the string to split into n-grams
+//   - n: the n-gram length, must be greater than zero
+//
+// Returns: the n-grams in order, or an empty slice when n <= 0 or
+// n is larger than the number of characters in s
+func NGrams(s string, n int) []string {
+	runes := []rune(s)
+	if n <= 0 || n > len(runes) {
+		return []string{}
+	}
+
+	// A string of length L has exactly L-n+1 n-grams
+	grams := make([]string, 0, len(runes)-n+1)
+	for i := 0; i+n <= len(runes); i++ {
+		grams = append(grams, string(runes[i:i+n]))
+	}
+	return grams
+}
+
+// WordNGrams returns every contiguous sequence of n words in text, each
+// joined with a single space
+// Words are split with strings.Fields, so any run of whitespace separates
+// words. Example: WordNGrams("the quick brown fox", 2) returns
+// ["the quick", "quick brown", "brown fox"]
+// Parameters:
+//   - text: the text to split into word n-grams
+//   - n: the number of words per n-gram, must be greater than zero
+//
+// Returns: the word n-grams in order, or an empty slice when n <= 0 or
+// text has fewer than n words
+func WordNGrams(text string, n int) []string {
+	words := strings.Fields(text)
+	if n <= 0 || n > len(words) {
+		return []string{}
+	}
+
+	grams := make([]string, 0, len(words)-n+1)
+	for i := 0; i+n <= len(words); i++ {
+		grams = append(grams, strings.Join(words[i:i+n], " "))
+	}
+	return grams
+}
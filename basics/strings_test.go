@@ -0,0 +1,112 @@
+// Package basics - Tests for string processing functions
+package basics
+
+import "testing"
+
+// TestRunLengthEncode verifies the encoded form of several inputs
+func TestRunLengthEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"repeated runs", "aaabcc", "a3b1c2"},
+		{"no repeats", "abc", "a1b1c1"},
+		{"single run", "zzzzzzzzzzzz", "z12"},
+		{"unicode", "ééé世", "é3世1"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RunLengthEncode(tt.input); got != tt.expected {
+				t.Errorf("RunLengthEncode(%q) = %q; want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCompressionRatio verifies the ratio for repetitive and varied input
+func TestCompressionRatio(t *testing.T) {
+	// Highly repetitive input compresses well: 100 chars -> "a100" (4 chars)
+	repetitive := ""
+	for i := 0; i < 100; i++ {
+		repetitive += "a"
+	}
+	if ratio := CompressionRatio(repetitive); ratio >= 0.1 {
+		t.Errorf("CompressionRatio(100 x 'a') = %f; want < 0.1", ratio)
+	}
+
+	// Varied input doubles in size because every character gets a count
+	if ratio := CompressionRatio("abcdef"); ratio < 1 {
+		t.Errorf("CompressionRatio(%q) = %f; want >= 1", "abcdef", ratio)
+	}
+
+	// Empty input must not divide by zero
+	if ratio := CompressionRatio(""); ratio != 0 {
+		t.Errorf("CompressionRatio(\"\") = %f; want 0", ratio)
+	}
+}
+
+// TestNGrams verifies character n-grams for ASCII and Unicode strings
+func TestNGrams(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		n        int
+		expected []string
+	}{
+		{"ascii trigrams", "golang", 3, []string{"gol", "ola", "lan", "ang"}},
+		{"ascii unigrams", "abc", 1, []string{"a", "b", "c"}},
+		{"unicode bigrams", "héllo世", 2, []string{"hé", "él", "ll", "lo", "o世"}},
+		{"n equals length", "go", 2, []string{"go"}},
+		{"n exceeds length", "go", 3, []string{}},
+		{"n is zero", "go", 0, []string{}},
+		{"n is negative", "go", -1, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NGrams(tt.input, tt.n)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("NGrams(%q, %d) = %q; want %q", tt.input, tt.n, got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("NGrams(%q, %d)[%d] = %q; want %q", tt.input, tt.n, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+// TestWordNGrams verifies word bigrams, trigrams and short input
+func TestWordNGrams(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		n        int
+		expected []string
+	}{
+		{"bigrams", "the quick brown fox", 2, []string{"the quick", "quick brown", "brown fox"}},
+		{"trigrams", "the quick brown fox", 3, []string{"the quick brown", "quick brown fox"}},
+		{"extra whitespace", "  go   is\tfun ", 2, []string{"go is", "is fun"}},
+		{"fewer words than n", "hello world", 3, []string{}},
+		{"empty text", "", 1, []string{}},
+		{"n is zero", "hello world", 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WordNGrams(tt.input, tt.n)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("WordNGrams(%q, %d) = %q; want %q", tt.input, tt.n, got, tt.expected)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("WordNGrams(%q, %d)[%d] = %q; want %q", tt.input, tt.n, i, got[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Package collections - Grouping a stream of items into batches
+package collections
+
+// Batcher collects items and hands them to a flush callback in groups of
+// a fixed size
+// Batching is common when each call is expensive, such as a database
+// insert or a network request, so sending 100 rows at once beats sending
+// them one by one. A Batcher is not safe for concurrent use
+type Batcher[T any] struct {
+	size  int
+	flush func([]T)
+	batch []T
+}
+
+// NewBatcher creates a Batcher that calls flush with every size items
+// A non-positive size is treated as 1
+func NewBatcher[T any](size int, flush func([]T)) *Batcher[T] {
+	if size <= 0 {
+		size = 1
+	}
+	return &Batcher[T]{size: size, flush: flush, batch: make([]T, 0, size)}
+}
+
+// Add appends item to the current batch and flushes it once it is full
+func (b *Batcher[T]) Add(item T) {
+	b.batch = append(b.batch, item)
+	if len(b.batch) == b.size {
+		b.flushBatch()
+	}
+}
+
+// Close flushes any remaining items as a final, smaller batch
+// Nothing is flushed if the current batch is empty. The Batcher can
+// keep being used after Close
+func (b *Batcher[T]) Close() {
+	if len(b.batch) > 0 {
+		b.flushBatch()
+	}
+}
+
+// flushBatch passes the current batch to flush and starts a new one
+// A fresh slice is allocated so flush may keep the one it was given
+func (b *Batcher[T]) flushBatch() {
+	full := b.batch
+	b.batch = make([]T, 0, b.size)
+	b.flush(full)
+}
@@ -0,0 +1,60 @@
+// Package collections contains tests for the Batcher type
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestBatcher verifies batches flush at size boundaries and on Close
+func TestBatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		items    int
+		expected [][]int
+	}{
+		{"exact multiple", 2, 4, [][]int{{0, 1}, {2, 3}}},
+		{"partial final batch", 3, 7, [][]int{{0, 1, 2}, {3, 4, 5}, {6}}},
+		{"fewer than one batch", 5, 2, [][]int{{0, 1}}},
+		{"no items", 3, 0, nil},
+		{"non-positive size", 0, 2, [][]int{{0}, {1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var flushed [][]int
+			b := NewBatcher(tt.size, func(batch []int) {
+				flushed = append(flushed, batch)
+			})
+			for i := 0; i < tt.items; i++ {
+				b.Add(i)
+			}
+			b.Close()
+
+			if !reflect.DeepEqual(flushed, tt.expected) {
+				t.Errorf("flushed batches = %v; want %v", flushed, tt.expected)
+			}
+		})
+	}
+}
+
+// TestBatcherFlushTiming verifies flush runs as soon as a batch fills,
+// not later
+func TestBatcherFlushTiming(t *testing.T) {
+	flushes := 0
+	b := NewBatcher(2, func([]string) { flushes++ })
+
+	b.Add("a")
+	if flushes != 0 {
+		t.Errorf("flushes after 1 item = %d; want 0", flushes)
+	}
+	b.Add("b")
+	if flushes != 1 {
+		t.Errorf("flushes after 2 items = %d; want 1", flushes)
+	}
+	b.Close() // Nothing pending, so no extra flush
+	if flushes != 1 {
+		t.Errorf("flushes after Close with empty batch = %d; want 1", flushes)
+	}
+}
@@ -0,0 +1,68 @@
+// Package collections - A cache interface with interchangeable implementations
+package collections
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Cache is the behaviour shared by every cache in this package
+// Code that accepts a Cache works with any implementation, so callers
+// can swap an unbounded map for a size-limited LRU without changing
+// anything else. Go interfaces are satisfied implicitly: a type only
+// needs these methods, with no "implements" declaration
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Len() int
+}
+
+// Compile-time checks that both types satisfy Cache
+// Assigning a nil pointer to a blank variable costs nothing at run time
+// but fails the build if a method is missing
+var (
+	_ Cache[string, int] = (*LRUCache[string, int])(nil)
+	_ Cache[string, int] = (*MapCache[string, int])(nil)
+)
+
+// MapCache is the simplest Cache: a plain map that never evicts
+// It suits small or bounded key sets; otherwise it grows without limit
+type MapCache[K comparable, V any] struct {
+	items map[K]V
+}
+
+// NewMapCache creates an empty, unbounded cache
+func NewMapCache[K comparable, V any]() *MapCache[K, V] {
+	return &MapCache[K, V]{items: make(map[K]V)}
+}
+
+// Get returns the value stored under key, if any
+func (c *MapCache[K, V]) Get(key K) (V, bool) {
+	v, ok := c.items[key]
+	return v, ok
+}
+
+// Put stores value under key, replacing any previous value
+func (c *MapCache[K, V]) Put(key K, value V) {
+	c.items[key] = value
+}
+
+// Len returns the number of cached entries
+func (c *MapCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// UseCache shows polymorphism: it only knows c through the Cache
+// interface, yet works with any implementation
+// It prints the concrete type behind the interface (found with %T) and
+// how many entries it holds
+func UseCache[K comparable, V any](c Cache[K, V]) {
+	describeCache(c, os.Stdout)
+}
+
+// describeCache writes UseCache's summary line to w, so tests can check
+// the text without capturing stdout
+func describeCache[K comparable, V any](c Cache[K, V], w io.Writer) {
+	fmt.Fprintf(w, "%T holds %d entries\n", c, c.Len())
+}
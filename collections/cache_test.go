@@ -0,0 +1,65 @@
+// Package collections contains tests for the Cache interface
+package collections
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestCacheImplementations runs the same checks on every implementation
+// through the interface
+func TestCacheImplementations(t *testing.T) {
+	caches := []struct {
+		name     string
+		cache    Cache[string, int]
+		typeName string // What %T prints for the concrete type
+	}{
+		{"MapCache", NewMapCache[string, int](), "*collections.MapCache[string,int]"},
+		{"LRUCache", NewLRUCache[string, int](10), "*collections.LRUCache[string,int]"},
+	}
+
+	for _, tc := range caches {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.cache
+			if _, ok := c.Get("missing"); ok {
+				t.Error("Get(\"missing\") on an empty cache reported present")
+			}
+
+			c.Put("a", 1)
+			c.Put("b", 2)
+			c.Put("a", 3) // Replace
+
+			if got, ok := c.Get("a"); !ok || got != 3 {
+				t.Errorf("Get(\"a\") = %d, %v; want 3, true", got, ok)
+			}
+			if got, ok := c.Get("b"); !ok || got != 2 {
+				t.Errorf("Get(\"b\") = %d, %v; want 2, true", got, ok)
+			}
+			if c.Len() != 2 {
+				t.Errorf("Len() = %d; want 2", c.Len())
+			}
+
+			var buf bytes.Buffer
+			describeCache(c, &buf)
+			if want := tc.typeName + " holds 2 entries\n"; buf.String() != want {
+				t.Errorf("describeCache() wrote %q; want %q", buf.String(), want)
+			}
+		})
+	}
+}
+
+// TestMapCacheUnbounded verifies MapCache never evicts, unlike LRUCache
+func TestMapCacheUnbounded(t *testing.T) {
+	var m Cache[int, int] = NewMapCache[int, int]()
+	var lru Cache[int, int] = NewLRUCache[int, int](3)
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+		lru.Put(i, i)
+	}
+	if m.Len() != 100 {
+		t.Errorf("MapCache Len() = %d; want 100", m.Len())
+	}
+	if lru.Len() != 3 {
+		t.Errorf("LRUCache Len() = %d; want 3", lru.Len())
+	}
+}
@@ -0,0 +1,17 @@
+// Package collections contains the fake clock shared by the timing tests
+package collections
+
+import "time"
+
+// fakeClock is a manually advanced clock for deterministic timing tests
+// Code under test takes its now function (clock.Now), so every test in
+// this package that depends on time uses this one type
+type fakeClock struct {
+	current time.Time
+}
+
+// Now returns the fake current time
+func (c *fakeClock) Now() time.Time { return c.current }
+
+// Advance moves the fake time forward by d
+func (c *fakeClock) Advance(d time.Duration) { c.current = c.current.Add(d) }
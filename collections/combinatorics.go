@@ -0,0 +1,133 @@
+// Package collections - Combinatorics helpers built from nested loops
+package collections
+
+// CartesianProduct returns every (a, b) combination of the two slices
+// Results are in row-major order: all pairs for a[0] come first, then
+// all pairs for a[1], and so on - exactly what two nested loops produce
+// The result has len(a)*len(b) elements and is empty if either input is
+func CartesianProduct[T any, U any](a []T, b []U) []Pair[T, U] {
+	// Pre-allocate since the final size is known up front
+	result := make([]Pair[T, U], 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			result = append(result, Pair[T, U]{First: x, Second: y})
+		}
+	}
+	return result
+}
+
+// Permutations returns every ordering of the elements in s
+// Be careful with input size: there are n! permutations, so 10 elements
+// already produce 3,628,800 slices. Each result is an independent slice
+// Permutations of an empty slice is a single empty ordering
+func Permutations[T any](s []T) [][]T {
+	var result [][]T
+	current := make([]T, 0, len(s))
+	used := make([]bool, len(s))
+
+	// Backtracking: pick each unused element for the next position,
+	// recurse, then undo the choice before trying the next one
+	var build func()
+	build = func() {
+		if len(current) == len(s) {
+			// Copy because current is reused across recursive calls
+			perm := make([]T, len(current))
+			copy(perm, current)
+			result = append(result, perm)
+			return
+		}
+		for i := range s {
+			if used[i] {
+				continue
+			}
+			used[i] = true
+			current = append(current, s[i])
+			build()
+			current = current[:len(current)-1]
+			used[i] = false
+		}
+	}
+	build()
+
+	return result
+}
+
+// Combinations returns every k-element subset of s in lexicographic
+// index order (for [a b c] and k=2: [a b], [a c], [b c])
+// The number of results is n! / (k! * (n-k)!), which also grows very
+// quickly. k=0 returns a single empty subset; k<0 or k>len(s) returns none
+func Combinations[T any](s []T, k int) [][]T {
+	result := [][]T{}
+	if k < 0 || k > len(s) {
+		return result
+	}
+
+	current := make([]T, 0, k)
+
+	// start is the first index we are still allowed to choose, which
+	// guarantees indices are increasing and no subset is repeated
+	var build func(start int)
+	build = func(start int) {
+		if len(current) == k {
+			combo := make([]T, k)
+			copy(combo, current)
+			result = append(result, combo)
+			return
+		}
+		// Stop early when there aren't enough elements left to fill k slots
+		for i := start; i <= len(s)-(k-len(current)); i++ {
+			current = append(current, s[i])
+			build(i + 1)
+			current = current[:len(current)-1]
+		}
+	}
+	build(0)
+
+	return result
+}
+
+// CartesianEach calls visit once for every combination that picks one
+// element from each set, without building the whole product in memory
+// Combinations are visited in row-major order (the last set changes
+// fastest), and enumeration stops as soon as visit returns false
+// The slice passed to visit is reused between calls, so copy it if it
+// must be kept. If any set is empty there are no combinations; with no
+// sets at all, visit is called once with an empty slice
+func CartesianEach[T any](sets [][]T, visit func([]T) bool) {
+	for _, set := range sets {
+		if len(set) == 0 {
+			return
+		}
+	}
+
+	// indices works like an odometer: one digit per set
+	indices := make([]int, len(sets))
+	current := make([]T, len(sets))
+	for i, set := range sets {
+		current[i] = set[0]
+	}
+
+	for {
+		if !visit(current) {
+			return
+		}
+
+		// Advance the odometer from the rightmost digit, carrying left
+		pos := len(sets) - 1
+		for pos >= 0 {
+			indices[pos]++
+			if indices[pos] < len(sets[pos]) {
+				current[pos] = sets[pos][indices[pos]]
+				break
+			}
+			indices[pos] = 0
+			current[pos] = sets[pos][0]
+			pos--
+		}
+
+		// Every digit wrapped around - all combinations were visited
+		if pos < 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Package collections contains tests for the combinatorics helpers
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCartesianProductSize verifies the result has len(a)*len(b) elements
+func TestCartesianProductSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []string
+		expected int
+	}{
+		{"2 x 3", []int{1, 2}, []string{"x", "y", "z"}, 6},
+		{"1 x 1", []int{1}, []string{"x"}, 1},
+		{"empty a", []int{}, []string{"x", "y"}, 0},
+		{"empty b", []int{1, 2}, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CartesianProduct(tt.a, tt.b)
+			if len(result) != tt.expected {
+				t.Errorf("len(CartesianProduct()) = %d; want %d", len(result), tt.expected)
+			}
+			if result == nil {
+				t.Errorf("CartesianProduct() returned nil; want empty slice")
+			}
+		})
+	}
+}
+
+// TestCartesianProductOrder verifies row-major ordering
+func TestCartesianProductOrder(t *testing.T) {
+	result := CartesianProduct([]string{"a", "b"}, []int{1, 2, 3})
+	expected := []Pair[string, int]{
+		{"a", 1}, {"a", 2}, {"a", 3},
+		{"b", 1}, {"b", 2}, {"b", 3},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("CartesianProduct() = %v; want %v", result, expected)
+	}
+}
+
+// TestPermutations verifies the count and content for a small input
+func TestPermutations(t *testing.T) {
+	result := Permutations([]int{1, 2, 3})
+	expected := [][]int{
+		{1, 2, 3}, {1, 3, 2},
+		{2, 1, 3}, {2, 3, 1},
+		{3, 1, 2}, {3, 2, 1},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Permutations([1 2 3]) = %v; want %v", result, expected)
+	}
+
+	// 4 elements -> 4! = 24 permutations
+	if n := len(Permutations([]string{"a", "b", "c", "d"})); n != 24 {
+		t.Errorf("len(Permutations(4 elements)) = %d; want 24", n)
+	}
+
+	// The empty slice has exactly one (empty) permutation
+	if n := len(Permutations([]int{})); n != 1 {
+		t.Errorf("len(Permutations([])) = %d; want 1", n)
+	}
+}
+
+// TestCombinations verifies lexicographic order and edge cases
+func TestCombinations(t *testing.T) {
+	input := []string{"a", "b", "c", "d"}
+
+	tests := []struct {
+		name     string
+		k        int
+		expected [][]string
+	}{
+		{"k=2", 2, [][]string{
+			{"a", "b"}, {"a", "c"}, {"a", "d"},
+			{"b", "c"}, {"b", "d"}, {"c", "d"},
+		}},
+		{"k=len", 4, [][]string{{"a", "b", "c", "d"}}},
+		{"k=0", 0, [][]string{{}}},
+		{"k>len", 5, [][]string{}},
+		{"negative k", -1, [][]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Combinations(input, tt.k)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Combinations(%v, %d) = %v; want %v", input, tt.k, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCartesianEach verifies every combination is visited in order
+func TestCartesianEach(t *testing.T) {
+	sets := [][]int{{1, 2}, {3, 4, 5}, {6, 7}}
+
+	var visited [][]int
+	CartesianEach(sets, func(combo []int) bool {
+		// Copy because the slice is reused between calls
+		visited = append(visited, append([]int(nil), combo...))
+		return true
+	})
+
+	if len(visited) != 2*3*2 {
+		t.Fatalf("CartesianEach visited %d combinations; want 12", len(visited))
+	}
+	if !reflect.DeepEqual(visited[0], []int{1, 3, 6}) || !reflect.DeepEqual(visited[1], []int{1, 3, 7}) {
+		t.Errorf("first combinations = %v, %v; want [1 3 6], [1 3 7]", visited[0], visited[1])
+	}
+	if !reflect.DeepEqual(visited[11], []int{2, 5, 7}) {
+		t.Errorf("last combination = %v; want [2 5 7]", visited[11])
+	}
+}
+
+// TestCartesianEachEarlyStop verifies returning false stops enumeration
+func TestCartesianEachEarlyStop(t *testing.T) {
+	calls := 0
+	CartesianEach([][]string{{"a", "b", "c"}, {"x", "y"}}, func(combo []string) bool {
+		calls++
+		return calls < 4
+	})
+	if calls != 4 {
+		t.Errorf("visit called %d times; want 4", calls)
+	}
+}
+
+// TestCartesianEachEdgeCases verifies empty sets and no sets
+func TestCartesianEachEdgeCases(t *testing.T) {
+	calls := 0
+	CartesianEach([][]int{{1, 2}, {}}, func([]int) bool { calls++; return true })
+	if calls != 0 {
+		t.Errorf("with an empty set, visit called %d times; want 0", calls)
+	}
+
+	calls = 0
+	CartesianEach([][]int{}, func(combo []int) bool {
+		calls++
+		return len(combo) == 0
+	})
+	if calls != 1 {
+		t.Errorf("with no sets, visit called %d times; want 1", calls)
+	}
+}
@@ -0,0 +1,74 @@
+// Package collections - A generic counter (bag / multiset) built on a map
+package collections
+
+import (
+	"cmp"
+	"sort"
+)
+
+// Counter counts how many times each item has been seen
+// It generalizes the "counting with maps" pattern from MapOperations
+// The element type must be ordered so that ties in MostCommon can be
+// broken by natural order, which keeps the output deterministic
+type Counter[T cmp.Ordered] struct {
+	// counts maps each item to the number of times it was added
+	counts map[T]int
+}
+
+// NewCounter creates an empty Counter ready for use
+func NewCounter[T cmp.Ordered]() *Counter[T] {
+	return &Counter[T]{counts: make(map[T]int)}
+}
+
+// Add increments the count of item by one
+func (c *Counter[T]) Add(item T) {
+	c.AddN(item, 1)
+}
+
+// AddN increments the count of item by n
+// A zero or negative n is ignored so counts never go below zero
+func (c *Counter[T]) AddN(item T, n int) {
+	if n <= 0 {
+		return
+	}
+	// Lazily initialize so the zero value Counter is usable too
+	if c.counts == nil {
+		c.counts = make(map[T]int)
+	}
+	c.counts[item] += n
+}
+
+// Count returns how many times item has been added
+// Items never added return 0 thanks to the map's zero value
+func (c *Counter[T]) Count(item T) int {
+	return c.counts[item]
+}
+
+// Len returns the number of distinct items in the counter
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
+// MostCommon returns the n most frequent items with their counts
+// Results are sorted by count (highest first), then by natural order
+// of the item for ties. A negative n or an n larger than the number of
+// distinct items returns every item
+func (c *Counter[T]) MostCommon(n int) []Pair[T, int] {
+	pairs := make([]Pair[T, int], 0, len(c.counts))
+	for item, count := range c.counts {
+		pairs = append(pairs, Pair[T, int]{First: item, Second: count})
+	}
+
+	// Map iteration order is random, so sorting is required for stable output
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Second != pairs[j].Second {
+			return pairs[i].Second > pairs[j].Second
+		}
+		return pairs[i].First < pairs[j].First
+	})
+
+	if n >= 0 && n < len(pairs) {
+		pairs = pairs[:n]
+	}
+	return pairs
+}
@@ -0,0 +1,81 @@
+// Package collections contains tests for the Counter type
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCounterIncremental verifies Add, AddN and Count work together
+func TestCounterIncremental(t *testing.T) {
+	c := NewCounter[string]()
+
+	c.Add("go")
+	c.Add("go")
+	c.AddN("rust", 3)
+	c.AddN("zig", 0) // Ignored
+
+	tests := []struct {
+		item     string
+		expected int
+	}{
+		{"go", 2},
+		{"rust", 3},
+		{"zig", 0},
+		{"missing", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.item, func(t *testing.T) {
+			if got := c.Count(tt.item); got != tt.expected {
+				t.Errorf("Count(%q) = %d; want %d", tt.item, got, tt.expected)
+			}
+		})
+	}
+
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d; want 2", c.Len())
+	}
+}
+
+// TestCounterZeroValue verifies a zero value Counter can be used directly
+func TestCounterZeroValue(t *testing.T) {
+	var c Counter[int]
+	c.Add(7)
+	if got := c.Count(7); got != 1 {
+		t.Errorf("Count(7) = %d; want 1", got)
+	}
+}
+
+// TestCounterMostCommon verifies ordering by count then natural order
+func TestCounterMostCommon(t *testing.T) {
+	c := NewCounter[string]()
+	for _, word := range []string{"b", "a", "c", "a", "b", "d", "c", "a"} {
+		c.Add(word)
+	}
+	// Counts: a=3, b=2, c=2, d=1 - b and c tie and must be ordered b, c
+
+	tests := []struct {
+		name     string
+		n        int
+		expected []Pair[string, int]
+	}{
+		{"top one", 1, []Pair[string, int]{{"a", 3}}},
+		{"tie broken by order", 3, []Pair[string, int]{{"a", 3}, {"b", 2}, {"c", 2}}},
+		{"more than available", 10, []Pair[string, int]{{"a", 3}, {"b", 2}, {"c", 2}, {"d", 1}}},
+		{"negative means all", -1, []Pair[string, int]{{"a", 3}, {"b", 2}, {"c", 2}, {"d", 1}}},
+		{"zero", 0, []Pair[string, int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Run several times - map iteration order must not leak into output
+			for i := 0; i < 5; i++ {
+				got := c.MostCommon(tt.n)
+				if !reflect.DeepEqual(got, tt.expected) {
+					t.Fatalf("MostCommon(%d) = %v; want %v", tt.n, got, tt.expected)
+				}
+			}
+		})
+	}
+}
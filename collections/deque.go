@@ -0,0 +1,79 @@
+// Package collections - A double-ended queue on a ring buffer
+package collections
+
+// Deque is a double-ended queue: items can be added and removed at both
+// the front and the back, so it works as a stack or a queue
+// It stores items in a ring buffer, a slice whose logical start (head)
+// can be anywhere and which wraps around at the end. Pushing and popping
+// just move head or the count, and the buffer doubles when full, so
+// every operation is amortized O(1). Compare the slice-based stack and
+// queue in SlicePatterns, where removing from the front of a queue
+// leaves unused memory behind. The zero value is an empty deque
+type Deque[T any] struct {
+	buf   []T
+	head  int // Index of the front item
+	count int
+}
+
+// Len returns the number of items in the deque
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// PushBack adds item at the back
+func (d *Deque[T]) PushBack(item T) {
+	d.growIfFull()
+	d.buf[(d.head+d.count)%len(d.buf)] = item
+	d.count++
+}
+
+// PushFront adds item at the front
+func (d *Deque[T]) PushFront(item T) {
+	d.growIfFull()
+	// Step head back one slot, wrapping to the end of the buffer
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = item
+	d.count++
+}
+
+// PopFront removes and returns the front item
+// On an empty deque it returns the zero value and false
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.count == 0 {
+		return zero, false
+	}
+	item := d.buf[d.head]
+	d.buf[d.head] = zero // Drop the reference so it can be garbage collected
+	d.head = (d.head + 1) % len(d.buf)
+	d.count--
+	return item, true
+}
+
+// PopBack removes and returns the back item
+// On an empty deque it returns the zero value and false
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.count == 0 {
+		return zero, false
+	}
+	tail := (d.head + d.count - 1) % len(d.buf)
+	item := d.buf[tail]
+	d.buf[tail] = zero
+	d.count--
+	return item, true
+}
+
+// growIfFull doubles the buffer when there is no free slot, copying the
+// items so the front lands at index 0 again
+func (d *Deque[T]) growIfFull() {
+	if d.count < len(d.buf) {
+		return
+	}
+	bigger := make([]T, max(2*len(d.buf), 8))
+	// Copy the part from head to the end, then the wrapped-around part
+	n := copy(bigger, d.buf[d.head:])
+	copy(bigger[n:], d.buf[:d.head])
+	d.buf = bigger
+	d.head = 0
+}
@@ -0,0 +1,108 @@
+// Package collections contains tests for the Deque type
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// drainFront pops every item from the front
+func drainFront(d *Deque[int]) []int {
+	result := []int{}
+	for {
+		item, ok := d.PopFront()
+		if !ok {
+			return result
+		}
+		result = append(result, item)
+	}
+}
+
+// TestDequeMixedOperations verifies ordering across both ends
+func TestDequeMixedOperations(t *testing.T) {
+	var d Deque[int]
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	d.PushBack(4)
+
+	if d.Len() != 5 {
+		t.Errorf("Len() = %d; want 5", d.Len())
+	}
+	if got, ok := d.PopBack(); !ok || got != 4 {
+		t.Errorf("PopBack() = %d, %v; want 4, true", got, ok)
+	}
+	if got, ok := d.PopFront(); !ok || got != 0 {
+		t.Errorf("PopFront() = %d, %v; want 0, true", got, ok)
+	}
+	if got := drainFront(&d); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("remaining items = %v; want [1 2 3]", got)
+	}
+}
+
+// TestDequeAsStackAndQueue verifies LIFO and FIFO usage
+func TestDequeAsStackAndQueue(t *testing.T) {
+	var stack Deque[int]
+	for i := 1; i <= 3; i++ {
+		stack.PushBack(i)
+	}
+	for _, want := range []int{3, 2, 1} {
+		if got, _ := stack.PopBack(); got != want {
+			t.Errorf("stack PopBack() = %d; want %d", got, want)
+		}
+	}
+
+	var queue Deque[int]
+	for i := 1; i <= 3; i++ {
+		queue.PushBack(i)
+	}
+	if got := drainFront(&queue); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("queue order = %v; want [1 2 3]", got)
+	}
+}
+
+// TestDequeWrapAndGrow verifies order survives wrap-around and resizing
+func TestDequeWrapAndGrow(t *testing.T) {
+	var d Deque[int]
+	var expected []int
+
+	// Interleave pushes and pops so head moves around the ring while the
+	// buffer grows several times
+	next := 0
+	for round := 0; round < 50; round++ {
+		for i := 0; i < 3; i++ {
+			d.PushBack(next)
+			expected = append(expected, next)
+			next++
+		}
+		got, _ := d.PopFront()
+		if got != expected[0] {
+			t.Fatalf("round %d: PopFront() = %d; want %d", round, got, expected[0])
+		}
+		expected = expected[1:]
+	}
+	d.PushFront(-1)
+	expected = append([]int{-1}, expected...)
+
+	if got := drainFront(&d); !reflect.DeepEqual(got, expected) {
+		t.Errorf("items after wrapping = %v; want %v", got, expected)
+	}
+}
+
+// TestDequeUnderflow verifies pops on an empty deque
+func TestDequeUnderflow(t *testing.T) {
+	var d Deque[string]
+	if got, ok := d.PopFront(); ok || got != "" {
+		t.Errorf("PopFront() on empty = %q, %v; want \"\", false", got, ok)
+	}
+	if got, ok := d.PopBack(); ok || got != "" {
+		t.Errorf("PopBack() on empty = %q, %v; want \"\", false", got, ok)
+	}
+
+	d.PushFront("x")
+	d.PopBack()
+	if _, ok := d.PopBack(); ok || d.Len() != 0 {
+		t.Errorf("PopBack() after emptying = ok %v, Len() %d; want false, 0", ok, d.Len())
+	}
+}
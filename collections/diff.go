@@ -0,0 +1,56 @@
+// Package collections - Comparing two slices as unordered collections
+package collections
+
+// Diff reports how new differs from old, ignoring order
+// added holds the elements that appear in new but not in old, and
+// removed holds those that appear in old but not in new. Each element is
+// reported once, in the order it first appears, even if it is repeated
+// Identical slices (in any order) give two empty results
+func Diff[T comparable](old, new []T) (added []T, removed []T) {
+	return onlyIn(new, old), onlyIn(old, new)
+}
+
+// onlyIn returns the distinct elements of a that are missing from b
+// A map of b's elements makes each lookup O(1) instead of scanning b
+func onlyIn[T comparable](a, b []T) []T {
+	inB := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		inB[v] = struct{}{}
+	}
+
+	result := make([]T, 0)
+	seen := make(map[T]struct{})
+	for _, v := range a {
+		if _, ok := inB[v]; ok {
+			continue
+		}
+		if _, dup := seen[v]; dup {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// EqualUnordered reports whether a and b hold the same elements with the
+// same multiplicities, in any order (they are equal as multisets)
+// Unlike Diff, duplicates matter: [1 1 2] and [1 2 2] are not equal
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	// Count a's elements up, then count b's back down
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
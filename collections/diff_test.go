@@ -0,0 +1,59 @@
+// Package collections contains tests for the slice comparison helpers
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestDiff verifies added and removed elements are reported
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name            string
+		old, new        []string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		{"additions only", []string{"a", "b"}, []string{"a", "b", "c", "d"}, []string{"c", "d"}, []string{}},
+		{"removals only", []string{"a", "b", "c"}, []string{"b"}, []string{}, []string{"a", "c"}},
+		{"both", []string{"a", "b", "c"}, []string{"c", "d", "a"}, []string{"d"}, []string{"b"}},
+		{"identical", []string{"a", "b"}, []string{"a", "b"}, []string{}, []string{}},
+		{"reordered", []string{"a", "b", "c"}, []string{"c", "a", "b"}, []string{}, []string{}},
+		{"duplicates reported once", []string{"a"}, []string{"b", "b", "a"}, []string{"b"}, []string{}},
+		{"both empty", nil, nil, []string{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := Diff(tt.old, tt.new)
+			if !reflect.DeepEqual(added, tt.expectedAdded) || !reflect.DeepEqual(removed, tt.expectedRemoved) {
+				t.Errorf("Diff(%v, %v) = %v, %v; want %v, %v",
+					tt.old, tt.new, added, removed, tt.expectedAdded, tt.expectedRemoved)
+			}
+		})
+	}
+}
+
+// TestEqualUnordered verifies multiset equality
+func TestEqualUnordered(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected bool
+	}{
+		{"same order", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"reordered", []int{3, 1, 2, 1}, []int{1, 1, 2, 3}, true},
+		{"duplicate counts differ", []int{1, 1, 2}, []int{1, 2, 2}, false},
+		{"different lengths", []int{1, 2}, []int{1, 2, 2}, false},
+		{"different elements", []int{1, 2}, []int{1, 3}, false},
+		{"both empty", []int{}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualUnordered(tt.a, tt.b); got != tt.expected {
+				t.Errorf("EqualUnordered(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
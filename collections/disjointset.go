@@ -0,0 +1,64 @@
+// Package collections - Union-find (disjoint-set) structure
+package collections
+
+// DisjointSet tracks which of the elements 0..n-1 belong to the same
+// group, and can merge groups quickly. It answers questions like "are
+// these two computers on the same network?" as links are added
+// Each group is a tree stored in parent; the root is the group's
+// representative. Two tricks keep the trees flat, making operations
+// nearly O(1): path compression (Find points nodes straight at the
+// root) and union by size (the smaller tree goes under the larger)
+type DisjointSet struct {
+	parent []int
+	size   []int // Tree size, only meaningful at roots
+}
+
+// NewDisjointSet creates n elements, each in a group of its own
+// Elements are numbered 0 to n-1; using others panics like an out of
+// range slice index
+func NewDisjointSet(n int) *DisjointSet {
+	n = max(n, 0)
+	d := &DisjointSet{parent: make([]int, n), size: make([]int, n)}
+	for i := range d.parent {
+		d.parent[i] = i // Every element starts as its own root
+		d.size[i] = 1
+	}
+	return d
+}
+
+// Find returns the representative (root) of x's group
+// Along the way it points every visited node directly at the root, so
+// later lookups on the same path take one step
+func (d *DisjointSet) Find(x int) int {
+	root := x
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	// Second pass: path compression
+	for d.parent[x] != root {
+		next := d.parent[x]
+		d.parent[x] = root
+		x = next
+	}
+	return root
+}
+
+// Union merges the groups containing a and b
+// Joining elements already in the same group has no effect
+func (d *DisjointSet) Union(a, b int) {
+	rootA, rootB := d.Find(a), d.Find(b)
+	if rootA == rootB {
+		return
+	}
+	// Hang the smaller tree under the larger so trees stay shallow
+	if d.size[rootA] < d.size[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+}
+
+// Connected reports whether a and b are in the same group
+func (d *DisjointSet) Connected(a, b int) bool {
+	return d.Find(a) == d.Find(b)
+}
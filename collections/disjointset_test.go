@@ -0,0 +1,60 @@
+// Package collections contains tests for the DisjointSet type
+package collections
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestDisjointSetComponents verifies groupings after a series of unions
+func TestDisjointSetComponents(t *testing.T) {
+	// Components after the unions: {0 1 2 3}, {4 5}, {6}, {7 8 9}
+	d := NewDisjointSet(10)
+	unions := [][2]int{{0, 1}, {2, 3}, {1, 3}, {4, 5}, {7, 8}, {8, 9}, {9, 7}}
+	for _, u := range unions {
+		d.Union(u[0], u[1])
+	}
+
+	component := []int{0, 0, 0, 0, 1, 1, 2, 3, 3, 3}
+	for a := range component {
+		for b := range component {
+			want := component[a] == component[b]
+			if got := d.Connected(a, b); got != want {
+				t.Errorf("Connected(%d, %d) = %v; want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+// TestDisjointSetInitial verifies every element starts alone
+func TestDisjointSetInitial(t *testing.T) {
+	d := NewDisjointSet(4)
+	for i := 0; i < 4; i++ {
+		t.Run(fmt.Sprintf("element %d", i), func(t *testing.T) {
+			if d.Find(i) != i {
+				t.Errorf("Find(%d) = %d; want %d", i, d.Find(i), i)
+			}
+			if i > 0 && d.Connected(i, i-1) {
+				t.Errorf("Connected(%d, %d) = true before any union", i, i-1)
+			}
+		})
+	}
+}
+
+// TestDisjointSetPathCompression verifies Find flattens long chains
+func TestDisjointSetPathCompression(t *testing.T) {
+	d := NewDisjointSet(5)
+	// Build a chain by hand, which unions by size would never produce
+	for i := 1; i < 5; i++ {
+		d.parent[i] = i - 1
+	}
+
+	if root := d.Find(4); root != 0 {
+		t.Fatalf("Find(4) = %d; want 0", root)
+	}
+	for i := 1; i < 5; i++ {
+		if d.parent[i] != 0 {
+			t.Errorf("parent[%d] = %d after Find; want 0 (compressed)", i, d.parent[i])
+		}
+	}
+}
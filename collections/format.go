@@ -0,0 +1,138 @@
+// Package collections - Formatting slices of rows as text tables
+package collections
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatTable writes an aligned text table to w
+// Each column is padded to the width of its widest cell (header included)
+// and columns are separated by two spaces. A dashed line separates the
+// header from the rows. Missing cells in short rows are left blank
+// Writing to an io.Writer instead of printing lets callers send the table
+// to a file, a buffer in tests, or os.Stdout
+func FormatTable(headers []string, rows [][]string, w io.Writer) {
+	widths := columnWidths(headers, rows)
+
+	writeTableRow(w, headers, widths)
+
+	dashes := make([]string, len(widths))
+	for i, width := range widths {
+		dashes[i] = strings.Repeat("-", width)
+	}
+	writeTableRow(w, dashes, widths)
+
+	for _, row := range rows {
+		writeTableRow(w, row, widths)
+	}
+}
+
+// columnWidths returns the widest cell, in runes, for every column
+// The number of columns is the larger of the header count and the
+// longest row
+func columnWidths(headers []string, rows [][]string) []int {
+	columns := len(headers)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	widths := make([]int, columns)
+	for _, row := range append([][]string{headers}, rows...) {
+		for i, cell := range row {
+			// Count runes, not bytes, so "café" is 4 wide
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	return widths
+}
+
+// writeTableRow writes one padded row followed by a newline
+// The last column is not padded to avoid trailing whitespace
+func writeTableRow(w io.Writer, cells []string, widths []int) {
+	var line strings.Builder
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		if i > 0 {
+			line.WriteString("  ")
+		}
+		line.WriteString(cell)
+		if i < len(widths)-1 {
+			line.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(cell)))
+		}
+	}
+	fmt.Fprintln(w, strings.TrimRight(line.String(), " "))
+}
+
+// FormatMarkdownTable returns the table as GitHub-flavored markdown
+//
+//	| Name  | Qty |
+//	| ----- | --- |
+//	| apple | 10  |
+//
+// Rows with fewer cells than the header are padded with empty cells, and
+// rows with more cells widen the table (with blank header cells) rather
+// than silently dropping data. Pipe characters inside cells are escaped
+// as \| so they don't split the cell
+func FormatMarkdownTable(headers []string, rows [][]string) string {
+	// Escape pipes first so widths account for the extra backslash
+	escape := func(cells []string) []string {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+		return escaped
+	}
+	headers = escape(headers)
+	escapedRows := make([][]string, len(rows))
+	for i, row := range rows {
+		escapedRows[i] = escape(row)
+	}
+
+	widths := columnWidths(headers, escapedRows)
+	for i := range widths {
+		// Markdown needs at least three dashes in the separator
+		if widths[i] < 3 {
+			widths[i] = 3
+		}
+	}
+
+	var b strings.Builder
+	writeMarkdownRow(&b, headers, widths)
+
+	dashes := make([]string, len(widths))
+	for i, width := range widths {
+		dashes[i] = strings.Repeat("-", width)
+	}
+	writeMarkdownRow(&b, dashes, widths)
+
+	for _, row := range escapedRows {
+		writeMarkdownRow(&b, row, widths)
+	}
+	return b.String()
+}
+
+// writeMarkdownRow writes one "| a | b |" row padded to the column widths
+func writeMarkdownRow(b *strings.Builder, cells []string, widths []int) {
+	b.WriteString("|")
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		b.WriteString(" ")
+		b.WriteString(cell)
+		b.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(cell)))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,108 @@
+// Package collections contains tests for the table formatters
+package collections
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestFormatTable verifies exact output for a small table
+func TestFormatTable(t *testing.T) {
+	var buf bytes.Buffer
+	FormatTable(
+		[]string{"Name", "Qty", "Price"},
+		[][]string{
+			{"apple", "10", "0.50"},
+			{"watermelon", "2", "3.00"},
+		},
+		&buf,
+	)
+
+	expected := "" +
+		"Name        Qty  Price\n" +
+		"----------  ---  -----\n" +
+		"apple       10   0.50\n" +
+		"watermelon  2    3.00\n"
+	if buf.String() != expected {
+		t.Errorf("FormatTable() =\n%s\nwant\n%s", buf.String(), expected)
+	}
+}
+
+// TestFormatTableAlignment verifies every column starts at the same offset
+func TestFormatTableAlignment(t *testing.T) {
+	var buf bytes.Buffer
+	FormatTable(
+		[]string{"a", "b", "c"},
+		[][]string{
+			{"x", "longer cell", "z"},
+			{"much longer cell", "y"}, // Short row - last cell blank
+			{"café", "ü", "end"},
+		},
+		&buf,
+	)
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("FormatTable() produced %d lines; want 5", len(lines))
+	}
+
+	// The second column starts after the widest first cell plus two spaces
+	secondColumn := len([]rune("much longer cell")) + 2
+	for _, line := range lines {
+		runes := []rune(line)
+		if len(runes) <= secondColumn {
+			t.Fatalf("line %q is too short", line)
+		}
+		if runes[secondColumn-1] != ' ' || runes[secondColumn] == ' ' {
+			t.Errorf("line %q: second column not aligned at offset %d", line, secondColumn)
+		}
+	}
+}
+
+// TestFormatMarkdownTable verifies the separator line and pipe placement
+func TestFormatMarkdownTable(t *testing.T) {
+	got := FormatMarkdownTable(
+		[]string{"Name", "Qty"},
+		[][]string{
+			{"apple", "10"},
+			{"a|b", "2"},
+		},
+	)
+
+	expected := "" +
+		"| Name  | Qty |\n" +
+		"| ----- | --- |\n" +
+		"| apple | 10  |\n" +
+		"| a\\|b  | 2   |\n"
+	if got != expected {
+		t.Errorf("FormatMarkdownTable() =\n%s\nwant\n%s", got, expected)
+	}
+}
+
+// TestFormatMarkdownTableRaggedRows verifies short rows are padded and
+// long rows widen the table
+func TestFormatMarkdownTableRaggedRows(t *testing.T) {
+	got := FormatMarkdownTable(
+		[]string{"A", "B"},
+		[][]string{
+			{"1"},
+			{"1", "2", "3"},
+		},
+	)
+
+	lines := strings.Split(strings.TrimRight(got, "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("FormatMarkdownTable() produced %d lines; want 4", len(lines))
+	}
+
+	// Every line must have the same number of pipes (3 columns -> 4 pipes)
+	for _, line := range lines {
+		if n := strings.Count(line, "|"); n != 4 {
+			t.Errorf("line %q has %d pipes; want 4", line, n)
+		}
+	}
+	if lines[1] != "| --- | --- | --- |" {
+		t.Errorf("separator line = %q; want %q", lines[1], "| --- | --- | --- |")
+	}
+}
@@ -0,0 +1,231 @@
+// Package collections - Graph algorithms on adjacency maps
+package collections
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TopologicalSort orders the nodes of a dependency graph so that every
+// node comes after all of its dependencies, e.g. the order to build
+// packages or run migrations
+// graph maps each node to the nodes it depends on. Nodes that only
+// appear as dependencies are included too. It uses Kahn's algorithm:
+// repeatedly emit a node with no unfinished dependencies. When several
+// are ready at once, the alphabetically first goes next, so the result
+// is deterministic
+// If the graph has a cycle (a needs b, b needs a) no valid order exists,
+// and an error listing the nodes caught in or behind it is returned
+func TopologicalSort(graph map[string][]string) ([]string, error) {
+	// pending counts each node's unfinished dependencies, and dependents
+	// is the reverse graph: who is waiting on each node
+	pending := make(map[string]int)
+	dependents := make(map[string][]string)
+	for node, deps := range graph {
+		if _, ok := pending[node]; !ok {
+			pending[node] = 0
+		}
+		for _, dep := range deps {
+			if _, ok := pending[dep]; !ok {
+				pending[dep] = 0
+			}
+			pending[node]++
+			dependents[dep] = append(dependents[dep], node)
+		}
+	}
+
+	var ready []string
+	for node, count := range pending {
+		if count == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	order := make([]string, 0, len(pending))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		node := ready[0]
+		ready = ready[1:]
+		order = append(order, node)
+
+		// node is done, so its dependents have one fewer to wait for
+		for _, dependent := range dependents[node] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(order) < len(pending) {
+		// Whatever never became ready is in a cycle or depends on one
+		var stuck []string
+		for node, count := range pending {
+			if count > 0 {
+				stuck = append(stuck, node)
+			}
+		}
+		sort.Strings(stuck)
+		return nil, fmt.Errorf("topological sort: cycle detected among %s", strings.Join(stuck, ", "))
+	}
+	return order, nil
+}
+
+// sortedNeighbors returns a sorted copy of node's neighbours, so the
+// traversals below don't depend on how the input lists were ordered
+func sortedNeighbors(graph map[string][]string, node string) []string {
+	neighbors := append([]string(nil), graph[node]...)
+	sort.Strings(neighbors)
+	return neighbors
+}
+
+// BFS lists the nodes reachable from start in breadth-first order:
+// start, then its neighbours, then their neighbours, and so on
+// graph maps each node to the nodes it has edges to. Neighbours are
+// visited in sorted order, and nodes not reachable from start are left
+// out. A Deque serves as the FIFO queue of nodes waiting to be visited
+func BFS(graph map[string][]string, start string) []string {
+	visited := map[string]bool{start: true}
+	order := []string{}
+
+	var queue Deque[string]
+	queue.PushBack(start)
+	for queue.Len() > 0 {
+		node, _ := queue.PopFront()
+		order = append(order, node)
+		for _, next := range sortedNeighbors(graph, node) {
+			// Mark when queued, not when visited, so no node is queued twice
+			if !visited[next] {
+				visited[next] = true
+				queue.PushBack(next)
+			}
+		}
+	}
+	return order
+}
+
+// DFS lists the nodes reachable from start in depth-first order: it
+// follows the first neighbour as deep as possible before backtracking
+// to try the next one
+// Like BFS, neighbours are taken in sorted order and unreachable nodes
+// are left out. The recursion mirrors the definition directly; very deep
+// graphs would need an explicit stack instead
+func DFS(graph map[string][]string, start string) []string {
+	visited := make(map[string]bool)
+	order := []string{}
+
+	var visit func(node string)
+	visit = func(node string) {
+		visited[node] = true
+		order = append(order, node)
+		for _, next := range sortedNeighbors(graph, node) {
+			if !visited[next] {
+				visit(next)
+			}
+		}
+	}
+	visit(start)
+	return order
+}
+
+// pathItem is a node waiting in Dijkstra's priority queue
+type pathItem struct {
+	node string
+	dist int
+}
+
+// pathQueue is a min-heap of pathItems ordered by distance (then name,
+// to keep ties deterministic). It implements heap.Interface, and the
+// container/heap functions do the actual sifting
+type pathQueue []pathItem
+
+// Len returns the number of queued items
+func (q pathQueue) Len() int { return len(q) }
+
+// Less orders items by distance, breaking ties by node name
+func (q pathQueue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	return q[i].node < q[j].node
+}
+
+// Swap exchanges two items; container/heap calls it while sifting
+func (q pathQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+// Push appends an item; use heap.Push, not this method, to keep heap order
+func (q *pathQueue) Push(x any) { *q = append(*q, x.(pathItem)) }
+
+// Pop removes the last item; use heap.Pop to get the smallest one
+func (q *pathQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// ShortestPath finds the cheapest route from start to end with
+// Dijkstra's algorithm and returns the nodes along it and its total cost
+// graph[a][b] is the weight of the edge from a to b. A min-heap always
+// hands back the closest unsettled node; once popped, its distance is
+// final, because every other route would already cost more. That
+// guarantee needs non-negative weights, so negative weights are an
+// error (listing every such edge), as is an end that can't be reached from start
+func ShortestPath(graph map[string]map[string]int, start, end string) ([]string, int, error) {
+	// Report every negative edge, sorted, since map order would make a
+	// single reported edge vary from run to run
+	var negative []string
+	for from, edges := range graph {
+		for to, weight := range edges {
+			if weight < 0 {
+				negative = append(negative, fmt.Sprintf("%s -> %s (%d)", from, to, weight))
+			}
+		}
+	}
+	if len(negative) > 0 {
+		sort.Strings(negative)
+		return nil, 0, fmt.Errorf("shortest path: negative weight on edges %s", strings.Join(negative, ", "))
+	}
+
+	dist := map[string]int{start: 0}
+	prev := make(map[string]string) // How we reached each node
+	settled := make(map[string]bool)
+	queue := &pathQueue{{node: start, dist: 0}}
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(pathItem)
+		if settled[current.node] {
+			continue // A stale entry; a shorter route was already found
+		}
+		settled[current.node] = true
+		if current.node == end {
+			break
+		}
+
+		for next, weight := range graph[current.node] {
+			candidate := current.dist + weight
+			if best, seen := dist[next]; !seen || candidate < best {
+				dist[next] = candidate
+				prev[next] = current.node
+				heap.Push(queue, pathItem{node: next, dist: candidate})
+			}
+		}
+	}
+
+	if !settled[end] {
+		return nil, 0, fmt.Errorf("shortest path: no path from %s to %s", start, end)
+	}
+
+	// Walk back from end to start, then reverse
+	path := []string{end}
+	for node := end; node != start; {
+		node = prev[node]
+		path = append(path, node)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, dist[end], nil
+}
@@ -0,0 +1,209 @@
+// Package collections contains tests for the graph algorithms
+package collections
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestTopologicalSort verifies dependencies come before dependents
+func TestTopologicalSort(t *testing.T) {
+	// Each key depends on the listed nodes
+	graph := map[string][]string{
+		"app":    {"db", "http", "log"},
+		"http":   {"log"},
+		"db":     {"config", "log"},
+		"log":    {"config"},
+		"config": nil,
+	}
+
+	got, err := TopologicalSort(graph)
+	if err != nil {
+		t.Fatalf("TopologicalSort() error = %v", err)
+	}
+
+	// Ties break alphabetically, so the order is fully determined
+	expected := []string{"config", "log", "db", "http", "app"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("TopologicalSort() = %v; want %v", got, expected)
+	}
+
+	// And every dependency really does come first
+	index := make(map[string]int)
+	for i, node := range got {
+		index[node] = i
+	}
+	for node, deps := range graph {
+		for _, dep := range deps {
+			if index[dep] > index[node] {
+				t.Errorf("%q comes before its dependency %q", node, dep)
+			}
+		}
+	}
+}
+
+// TestTopologicalSortImplicitNodes verifies nodes that only appear as
+// dependencies are included
+func TestTopologicalSortImplicitNodes(t *testing.T) {
+	got, err := TopologicalSort(map[string][]string{"b": {"a"}, "c": {"a"}})
+	if err != nil {
+		t.Fatalf("TopologicalSort() error = %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TopologicalSort() = %v; want %v", got, want)
+	}
+}
+
+// TestTopologicalSortCycle verifies cycles are reported
+func TestTopologicalSortCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		stuck string
+	}{
+		{"two-node cycle", map[string][]string{"a": {"b"}, "b": {"a"}}, "a, b"},
+		{"self loop", map[string][]string{"a": {"a"}}, "a"},
+		{
+			name:  "cycle with dependents",
+			graph: map[string][]string{"x": {"y"}, "y": {"z"}, "z": {"x"}, "top": {"x"}, "ok": nil},
+			stuck: "top, x, y, z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TopologicalSort(tt.graph)
+			if err == nil {
+				t.Fatalf("TopologicalSort() = %v; want a cycle error", got)
+			}
+			if !strings.Contains(err.Error(), tt.stuck) {
+				t.Errorf("error = %q; want it to list %q", err.Error(), tt.stuck)
+			}
+		})
+	}
+}
+
+// traversalGraph is shared by the BFS and DFS tests:
+//
+//	a -> c, b      (listed out of order on purpose)
+//	b -> d
+//	c -> d, e
+//	d -> a         (cycle back to the start)
+//	x -> y         (not reachable from a)
+var traversalGraph = map[string][]string{
+	"a": {"c", "b"},
+	"b": {"d"},
+	"c": {"d", "e"},
+	"d": {"a"},
+	"x": {"y"},
+}
+
+// TestBFS verifies level-by-level visiting order
+func TestBFS(t *testing.T) {
+	tests := []struct {
+		start    string
+		expected []string
+	}{
+		{"a", []string{"a", "b", "c", "d", "e"}},
+		{"c", []string{"c", "d", "e", "a", "b"}},
+		{"x", []string{"x", "y"}},
+		{"e", []string{"e"}},           // No outgoing edges
+		{"lonely", []string{"lonely"}}, // Not in the graph at all
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start, func(t *testing.T) {
+			if got := BFS(traversalGraph, tt.start); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("BFS(%q) = %v; want %v", tt.start, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDFS verifies deepest-first visiting order
+func TestDFS(t *testing.T) {
+	tests := []struct {
+		start    string
+		expected []string
+	}{
+		{"a", []string{"a", "b", "d", "c", "e"}},
+		{"c", []string{"c", "d", "a", "b", "e"}},
+		{"x", []string{"x", "y"}},
+		{"lonely", []string{"lonely"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start, func(t *testing.T) {
+			if got := DFS(traversalGraph, tt.start); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("DFS(%q) = %v; want %v", tt.start, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestShortestPath verifies the cheapest route and its cost
+func TestShortestPath(t *testing.T) {
+	// The direct A -> D edge is the most expensive way to get there
+	graph := map[string]map[string]int{
+		"A": {"B": 4, "C": 1, "D": 10},
+		"B": {"D": 1},
+		"C": {"B": 2, "E": 7},
+		"D": {"E": 3},
+		"E": {},
+		"Z": {"A": 1}, // Can reach A, but nothing reaches Z
+	}
+
+	tests := []struct {
+		start, end   string
+		expectedPath []string
+		expectedCost int
+	}{
+		{"A", "D", []string{"A", "C", "B", "D"}, 4},
+		{"A", "E", []string{"A", "C", "B", "D", "E"}, 7},
+		{"C", "D", []string{"C", "B", "D"}, 3},
+		{"A", "A", []string{"A"}, 0},
+		{"Z", "B", []string{"Z", "A", "C", "B"}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start+"->"+tt.end, func(t *testing.T) {
+			path, cost, err := ShortestPath(graph, tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("ShortestPath(%s, %s) error = %v", tt.start, tt.end, err)
+			}
+			if !reflect.DeepEqual(path, tt.expectedPath) || cost != tt.expectedCost {
+				t.Errorf("ShortestPath(%s, %s) = %v, %d; want %v, %d",
+					tt.start, tt.end, path, cost, tt.expectedPath, tt.expectedCost)
+			}
+		})
+	}
+}
+
+// TestShortestPathErrors verifies unreachable targets and negative weights
+func TestShortestPathErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		graph      map[string]map[string]int
+		start, end string
+		errMsg     string
+	}{
+		{"unreachable", map[string]map[string]int{"A": {"B": 1}, "C": {}}, "A", "C", "no path"},
+		{"unknown end", map[string]map[string]int{"A": {"B": 1}}, "A", "Q", "no path"},
+		{"wrong direction", map[string]map[string]int{"A": {"B": 1}}, "B", "A", "no path"},
+		{"negative weight", map[string]map[string]int{"A": {"B": -2}}, "A", "B", "negative weight"},
+		{"every negative edge, sorted", map[string]map[string]int{
+			"C": {"A": -1},
+			"A": {"C": -5, "B": -2},
+		}, "A", "B", "A -> B (-2), A -> C (-5), C -> A (-1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ShortestPath(tt.graph, tt.start, tt.end)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("ShortestPath(%s, %s) error = %v; want one containing %q", tt.start, tt.end, err, tt.errMsg)
+			}
+		})
+	}
+}
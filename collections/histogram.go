@@ -0,0 +1,107 @@
+// Package collections - Histograms: grouping numbers into buckets
+package collections
+
+import (
+	"fmt"
+	"math"
+)
+
+// Float is a constraint matching any floating-point type
+// It plays the same role as golang.org/x/exp/constraints.Float without
+// pulling in an external module
+type Float interface {
+	~float32 | ~float64
+}
+
+// Bucketize builds an equal-width histogram over the range of s
+// It returns bucketCount+1 edges (edges[i] and edges[i+1] bound bucket i)
+// and the number of values in each bucket. Buckets include their lower
+// edge; the last bucket also includes the maximum value. When every value
+// is the same, all of them land in the first bucket
+// An error is returned for bucketCount <= 0, an empty slice, or any
+// NaN or infinite value, since none of those can be placed on a scale
+func Bucketize[T Float](s []T, bucketCount int) (edges []T, counts []int, err error) {
+	if bucketCount <= 0 {
+		return nil, nil, fmt.Errorf("bucketize: bucketCount=%d must be positive", bucketCount)
+	}
+	if len(s) == 0 {
+		return nil, nil, fmt.Errorf("bucketize: empty input")
+	}
+	for i, v := range s {
+		if f := float64(v); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, nil, fmt.Errorf("bucketize: value %d is %v; want a finite number", i, v)
+		}
+	}
+
+	// Find the value range
+	low, high := s[0], s[0]
+	for _, v := range s[1:] {
+		low = min(low, v)
+		high = max(high, v)
+	}
+
+	// high-low overflows to +Inf for ranges wider than the largest
+	// float64 (e.g. -1e308 to 1e308). Only then, work with halved values
+	// and double the edges back; at that magnitude halving is exact.
+	// Ordinary ranges, including subnormal ones, use the plain width
+	lo, hi, scale := low, high, T(1)
+	if math.IsInf(float64(high-low), 0) {
+		lo, hi, scale = low/2, high/2, 2
+	}
+	width := (hi - lo) / T(bucketCount)
+
+	edges = make([]T, bucketCount+1)
+	for i := 1; i < bucketCount; i++ {
+		edges[i] = (lo + T(i)*width) * scale
+	}
+	// Pin the outer edges to the data so drift can't move them
+	edges[0], edges[bucketCount] = low, high
+
+	counts = make([]int, bucketCount)
+	for _, v := range s {
+		index := 0
+		if width > 0 {
+			index = int((v/scale - lo) / width)
+		}
+		// The maximum value (and any rounding overshoot) goes in the last
+		// bucket; rounding can never push a value below the first one, but
+		// clamp anyway so a bad index can't panic
+		index = min(max(index, 0), bucketCount-1)
+		counts[index]++
+	}
+
+	return edges, counts, nil
+}
+
+// LogHistogram groups positive values into exponentially growing buckets
+// Bucket k holds values in [base^k, base^(k+1)), computed as
+// floor(log_base(value)); with base 10 that groups numbers by their
+// number of digits. Zero, negative, infinite and NaN values have no
+// usable logarithm and are skipped. The result maps bucket index to count
+func LogHistogram(values []float64, base float64) map[int]int {
+	buckets := make(map[int]int)
+	// A base of 1 or less would make every logarithm undefined or zero
+	if base <= 1 {
+		return buckets
+	}
+
+	logBase := math.Log(base)
+	for _, v := range values {
+		// Infinity and NaN have no meaningful bucket either
+		if v <= 0 || math.IsInf(v, 1) || math.IsNaN(v) {
+			continue
+		}
+		bucket := int(math.Floor(math.Log(v) / logBase))
+		// Floating-point error can push the quotient across a boundary in
+		// either direction: exact powers like 1000 can land just under it
+		// (2.9999...), and values just below a power like 99999.99999999999
+		// can land on it (5.0), so check both neighbours
+		if math.Pow(base, float64(bucket+1)) <= v {
+			bucket++
+		} else if math.Pow(base, float64(bucket)) > v {
+			bucket--
+		}
+		buckets[bucket]++
+	}
+	return buckets
+}
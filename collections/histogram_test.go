@@ -0,0 +1,176 @@
+// Package collections contains tests for the histogram helpers
+package collections
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestBucketize verifies bucket edges and per-bucket counts
+func TestBucketize(t *testing.T) {
+	values := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 10}
+
+	edges, counts, err := Bucketize(values, 5)
+	if err != nil {
+		t.Fatalf("Bucketize() returned error: %v", err)
+	}
+
+	expectedEdges := []float64{0, 2, 4, 6, 8, 10}
+	if !reflect.DeepEqual(edges, expectedEdges) {
+		t.Errorf("edges = %v; want %v", edges, expectedEdges)
+	}
+
+	// [0,2) [2,4) [4,6) [6,8) [8,10] - the maximum joins the last bucket
+	expectedCounts := []int{2, 2, 2, 2, 2}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("counts = %v; want %v", counts, expectedCounts)
+	}
+}
+
+// TestBucketizeCountsSum verifies no value is lost or double counted
+func TestBucketizeCountsSum(t *testing.T) {
+	values := []float32{0.1, 0.35, 0.2, 0.95, 0.5, 0.51, 0.77, 0.33, 0.1}
+
+	for _, buckets := range []int{1, 2, 3, 7, 20} {
+		edges, counts, err := Bucketize(values, buckets)
+		if err != nil {
+			t.Fatalf("Bucketize(%d) returned error: %v", buckets, err)
+		}
+		if len(edges) != buckets+1 || len(counts) != buckets {
+			t.Errorf("Bucketize(%d): %d edges, %d counts; want %d, %d",
+				buckets, len(edges), len(counts), buckets+1, buckets)
+		}
+
+		total := 0
+		for _, c := range counts {
+			total += c
+		}
+		if total != len(values) {
+			t.Errorf("Bucketize(%d) counts sum to %d; want %d", buckets, total, len(values))
+		}
+	}
+}
+
+// TestBucketizeSameValues verifies a zero-width range doesn't divide by zero
+func TestBucketizeSameValues(t *testing.T) {
+	_, counts, err := Bucketize([]float64{3, 3, 3}, 4)
+	if err != nil {
+		t.Fatalf("Bucketize() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(counts, []int{3, 0, 0, 0}) {
+		t.Errorf("counts = %v; want [3 0 0 0]", counts)
+	}
+}
+
+// TestBucketizeErrors verifies invalid arguments are rejected
+func TestBucketizeErrors(t *testing.T) {
+	if _, _, err := Bucketize([]float64{1, 2}, 0); err == nil {
+		t.Error("Bucketize() with 0 buckets should return an error")
+	}
+	if _, _, err := Bucketize([]float64{1, 2}, -3); err == nil {
+		t.Error("Bucketize() with negative buckets should return an error")
+	}
+	if _, _, err := Bucketize([]float64{}, 3); err == nil {
+		t.Error("Bucketize() with empty input should return an error")
+	}
+	for _, bad := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if _, _, err := Bucketize([]float64{1, 2, bad}, 4); err == nil {
+			t.Errorf("Bucketize() with %v should return an error", bad)
+		}
+	}
+}
+
+// TestBucketizeSubnormal verifies tiny subnormal values keep their exact
+// minimum and maximum as the outer edges
+func TestBucketizeSubnormal(t *testing.T) {
+	edges, counts, err := Bucketize([]float64{5e-324, 1e-323, 2e-323}, 2)
+	if err != nil {
+		t.Fatalf("Bucketize() returned error: %v", err)
+	}
+
+	expectedEdges := []float64{5e-324, 1.5e-323, 2e-323}
+	if !reflect.DeepEqual(edges, expectedEdges) {
+		t.Errorf("edges = %v; want %v", edges, expectedEdges)
+	}
+	expectedCounts := []int{2, 1}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("counts = %v; want %v", counts, expectedCounts)
+	}
+}
+
+// TestBucketizeHugeRange verifies a range wider than the largest float64
+// (high-low overflows to +Inf) still produces finite edges and counts
+func TestBucketizeHugeRange(t *testing.T) {
+	edges, counts, err := Bucketize([]float64{-1e308, 0, 1e308}, 4)
+	if err != nil {
+		t.Fatalf("Bucketize() returned error: %v", err)
+	}
+
+	expectedEdges := []float64{-1e308, -5e307, 0, 5e307, 1e308}
+	if !reflect.DeepEqual(edges, expectedEdges) {
+		t.Errorf("edges = %v; want %v", edges, expectedEdges)
+	}
+	expectedCounts := []int{1, 0, 1, 1}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("counts = %v; want %v", counts, expectedCounts)
+	}
+}
+
+// TestLogHistogram verifies values are grouped by order of magnitude
+func TestLogHistogram(t *testing.T) {
+	values := []float64{1, 5, 9.99, 10, 50, 99, 100, 1000, 0.5}
+
+	got := LogHistogram(values, 10)
+	expected := map[int]int{
+		-1: 1, // 0.5
+		0:  3, // 1, 5, 9.99
+		1:  3, // 10, 50, 99
+		2:  1, // 100
+		3:  1, // 1000
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("LogHistogram(base 10) = %v; want %v", got, expected)
+	}
+
+	// Base 2 groups by powers of two
+	got = LogHistogram([]float64{1, 2, 3, 4, 7, 8}, 2)
+	expected = map[int]int{0: 1, 1: 2, 2: 2, 3: 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("LogHistogram(base 2) = %v; want %v", got, expected)
+	}
+}
+
+// TestLogHistogramSkipsNonPositive verifies zero and negatives are excluded
+func TestLogHistogramSkipsNonPositive(t *testing.T) {
+	got := LogHistogram([]float64{0, -1, -100, 10}, 10)
+	expected := map[int]int{1: 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("LogHistogram() = %v; want %v", got, expected)
+	}
+
+	if got := LogHistogram([]float64{0, -5}, 10); len(got) != 0 {
+		t.Errorf("LogHistogram(non-positive only) = %v; want empty", got)
+	}
+}
+
+// TestLogHistogramSkipsNonFinite verifies infinities and NaN are excluded
+func TestLogHistogramSkipsNonFinite(t *testing.T) {
+	got := LogHistogram([]float64{math.Inf(1), math.Inf(-1), math.NaN(), 10}, 10)
+	expected := map[int]int{1: 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("LogHistogram() = %v; want %v", got, expected)
+	}
+}
+
+// TestLogHistogramJustBelowPower verifies values a hair under a power of
+// the base stay in the lower bucket even when the logarithm rounds up
+func TestLogHistogramJustBelowPower(t *testing.T) {
+	// log(99999.99999999999) / log(10) rounds to exactly 5
+	v := math.Nextafter(1e5, 0)
+	got := LogHistogram([]float64{v, 1e5}, 10)
+	expected := map[int]int{4: 1, 5: 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("LogHistogram(%v, 1e5) = %v; want %v", v, got, expected)
+	}
+}
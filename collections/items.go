@@ -0,0 +1,58 @@
+// Package collections - Inventory items and JSON decoding
+package collections
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Item is a single inventory entry
+// The struct tags tell encoding/json which key each field maps to
+type Item struct {
+	Name     string  `json:"name"`
+	Price    float64 `json:"price"`
+	Quantity int     `json:"quantity"`
+}
+
+// DecodeItemsJSONL reads newline-delimited JSON (JSON Lines), where every
+// line holds one Item object, for example:
+//
+//	{"name": "apple", "price": 0.5, "quantity": 10}
+//	{"name": "pear", "price": 0.75, "quantity": 4}
+//
+// Blank lines are skipped. Reading line by line means large inputs never
+// need to be loaded into memory at once, and a bufio.Reader (unlike a
+// bufio.Scanner, which stops at 64KB) accepts lines of any length. A
+// malformed line stops decoding and the error reports its 1-based line
+// number
+func DecodeItemsJSONL(r io.Reader) ([]Item, error) {
+	items := []Item{}
+	reader := bufio.NewReader(r)
+
+	lineNumber := 0
+	for {
+		// ReadBytes returns the data read so far along with io.EOF, so a
+		// last line without a trailing newline is still decoded
+		raw, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, fmt.Errorf("decode items: %w", readErr)
+		}
+		if len(raw) > 0 {
+			lineNumber++
+			line := bytes.TrimSpace(raw)
+			if len(line) > 0 {
+				var item Item
+				if err := json.Unmarshal(line, &item); err != nil {
+					return nil, fmt.Errorf("decode items: line %d: %w", lineNumber, err)
+				}
+				items = append(items, item)
+			}
+		}
+		if readErr == io.EOF {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Package collections contains tests for inventory item decoding
+package collections
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestDecodeItemsJSONL verifies valid multi-line input is decoded in order
+func TestDecodeItemsJSONL(t *testing.T) {
+	input := `{"name": "apple", "price": 0.5, "quantity": 10}
+{"name": "pear", "price": 0.75, "quantity": 4}
+
+{"name": "plum", "price": 1.25, "quantity": 0}
+`
+	items, err := DecodeItemsJSONL(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("DecodeItemsJSONL() returned error: %v", err)
+	}
+
+	expected := []Item{
+		{Name: "apple", Price: 0.5, Quantity: 10},
+		{Name: "pear", Price: 0.75, Quantity: 4},
+		{Name: "plum", Price: 1.25, Quantity: 0},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("DecodeItemsJSONL() = %v; want %v", items, expected)
+	}
+}
+
+// TestDecodeItemsJSONLMalformed verifies the offending line is reported
+func TestDecodeItemsJSONLMalformed(t *testing.T) {
+	input := `{"name": "apple", "price": 0.5, "quantity": 10}
+{"name": "pear", "price": oops}
+{"name": "plum", "price": 1.25, "quantity": 0}
+`
+	items, err := DecodeItemsJSONL(strings.NewReader(input))
+	if err == nil {
+		t.Fatalf("DecodeItemsJSONL() = %v; want an error", items)
+	}
+	if !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Error message = %q, want to contain %q", err.Error(), "line 2")
+	}
+}
+
+// TestDecodeItemsJSONLEmpty verifies empty input yields no items
+func TestDecodeItemsJSONLEmpty(t *testing.T) {
+	items, err := DecodeItemsJSONL(strings.NewReader(""))
+	if err != nil || len(items) != 0 {
+		t.Errorf("DecodeItemsJSONL(\"\") = %v, %v; want empty, nil", items, err)
+	}
+}
+
+// TestDecodeItemsJSONLLongLine verifies lines longer than bufio.Scanner's
+// 64KB limit are decoded, including a final line with no newline
+func TestDecodeItemsJSONLLongLine(t *testing.T) {
+	longName := strings.Repeat("x", 200*1024)
+	input := `{"name": "apple", "price": 0.5, "quantity": 10}` + "\n" +
+		`{"name": "` + longName + `", "price": 2, "quantity": 1}`
+
+	items, err := DecodeItemsJSONL(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("DecodeItemsJSONL() returned error: %v", err)
+	}
+	if len(items) != 2 || items[1].Name != longName || items[1].Quantity != 1 {
+		t.Errorf("DecodeItemsJSONL() decoded %d items; want apple and a %d-byte name", len(items), len(longName))
+	}
+}
@@ -0,0 +1,95 @@
+// Package collections - Iterators built on Go 1.23 range-over-func
+package collections
+
+import "iter"
+
+// Seq returns an iterator over the elements of s, in order
+// It lets a slice be consumed with "for v := range Seq(s)" and passed to
+// anything that accepts an iter.Seq. Breaking out of the loop stops the
+// iteration: yield returns false and Seq returns without visiting the
+// remaining elements
+func Seq[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the key/value pairs of m
+// As with ranging over a map directly, the order is unspecified and may
+// differ between runs
+func Seq2[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns an iterator that yields fn(v) for every v in seq
+// Nothing is computed up front: fn runs only when the consumer asks for
+// the next element, so no intermediate slice is built and stopping
+// early skips the remaining calls
+func MapSeq[T any, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns an iterator over the elements of seq for which pred
+// returns true
+// Like MapSeq it is lazy, so MapSeq and FilterSeq can be stacked freely
+// and each element still passes through the whole chain one at a time
+func FilterSeq[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeSeq returns an iterator over at most the first n elements of seq
+// It stops pulling from seq as soon as the nth element has been yielded,
+// which is what makes it safe to use on an infinite sequence such as
+// Naturals. A non-positive n yields nothing
+func TakeSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Naturals returns an infinite iterator over 0, 1, 2, ...
+// It never ends on its own, so the consumer must break out of the loop
+// or limit it with TakeSeq
+func Naturals() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n := 0; ; n++ {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
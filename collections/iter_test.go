@@ -0,0 +1,181 @@
+// Package collections - Tests for iterator helpers
+package collections
+
+import (
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestSeq verifies ranging over Seq visits every element in order
+func TestSeq(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{"several", []int{3, 1, 2}},
+		{"single", []int{7}},
+		{"empty", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int{}
+			for v := range Seq(tt.input) {
+				got = append(got, v)
+			}
+			if !reflect.DeepEqual(got, tt.input) {
+				t.Errorf("Seq(%v) yielded %v; want %v", tt.input, got, tt.input)
+			}
+		})
+	}
+}
+
+// TestSeqBreak verifies breaking out of the loop stops iteration
+func TestSeqBreak(t *testing.T) {
+	got := []string{}
+	for v := range Seq([]string{"a", "b", "stop", "c"}) {
+		if v == "stop" {
+			break
+		}
+		got = append(got, v)
+	}
+	expected := []string{"a", "b"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Seq() before break yielded %v; want %v", got, expected)
+	}
+}
+
+// TestSeq2 verifies every map entry is yielded exactly once
+func TestSeq2(t *testing.T) {
+	input := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	got := map[string]int{}
+	for k, v := range Seq2(input) {
+		if _, seen := got[k]; seen {
+			t.Fatalf("Seq2() yielded key %q twice", k)
+		}
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, input) {
+		t.Errorf("Seq2(%v) yielded %v; want %v", input, got, input)
+	}
+}
+
+// TestSeq2Break verifies breaking out of the loop stops iteration
+func TestSeq2Break(t *testing.T) {
+	visited := 0
+	for range Seq2(map[int]bool{1: true, 2: true, 3: true}) {
+		visited++
+		break
+	}
+	if visited != 1 {
+		t.Errorf("Seq2() visited %d entries before break; want 1", visited)
+	}
+}
+
+// TestMapSeq verifies MapSeq transforms every element, changing its type
+func TestMapSeq(t *testing.T) {
+	got := []string{}
+	for v := range MapSeq(Seq([]string{"go", "is", "fun"}), strings.ToUpper) {
+		got = append(got, v)
+	}
+	expected := []string{"GO", "IS", "FUN"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("MapSeq(ToUpper) yielded %v; want %v", got, expected)
+	}
+}
+
+// TestFilterSeq verifies only matching elements are yielded
+func TestFilterSeq(t *testing.T) {
+	got := []int{}
+	for v := range FilterSeq(Seq([]int{1, 2, 3, 4, 5, 6}), func(n int) bool { return n%2 == 0 }) {
+		got = append(got, v)
+	}
+	expected := []int{2, 4, 6}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("FilterSeq(even) yielded %v; want %v", got, expected)
+	}
+}
+
+// TestMapThenFilterSeq verifies map then filter composes correctly and
+// lazily: the log shows each element flowing through both steps before
+// the next one starts, and nothing past the break is touched
+func TestMapThenFilterSeq(t *testing.T) {
+	var log []string
+	squared := MapSeq(Seq([]int{1, 2, 3, 4, 5}), func(n int) int {
+		log = append(log, "map "+strconv.Itoa(n))
+		return n * n
+	})
+	bigSquares := FilterSeq(squared, func(n int) bool {
+		log = append(log, "filter "+strconv.Itoa(n))
+		return n > 3
+	})
+
+	if len(log) != 0 {
+		t.Fatalf("building the chain ran %v; want nothing until ranged over", log)
+	}
+
+	got := []int{}
+	for v := range bigSquares {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	expected := []int{4, 9}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("FilterSeq(MapSeq(square), >3) yielded %v; want %v", got, expected)
+	}
+	expectedLog := []string{"map 1", "filter 1", "map 2", "filter 4", "map 3", "filter 9"}
+	if !reflect.DeepEqual(log, expectedLog) {
+		t.Errorf("evaluation order = %v; want %v", log, expectedLog)
+	}
+}
+
+// TestTakeSeq verifies TakeSeq yields exactly n values, or fewer when
+// the underlying sequence is shorter
+func TestTakeSeq(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   iter.Seq[int]
+		n        int
+		expected []int
+	}{
+		{"infinite", Naturals(), 5, []int{0, 1, 2, 3, 4}},
+		{"shorter input", Seq([]int{7, 8}), 5, []int{7, 8}},
+		{"zero", Naturals(), 0, []int{}},
+		{"negative", Naturals(), -3, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int{}
+			for v := range TakeSeq(tt.source, tt.n) {
+				got = append(got, v)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("TakeSeq(%d) yielded %v; want %v", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestTakeSeqStopsPulling verifies TakeSeq never asks the source for
+// more elements than it yields
+func TestTakeSeqStopsPulling(t *testing.T) {
+	pulled := 0
+	counted := MapSeq(Naturals(), func(n int) int {
+		pulled++
+		return n
+	})
+
+	for range TakeSeq(counted, 3) {
+	}
+	if pulled != 3 {
+		t.Errorf("TakeSeq(3) pulled %d elements; want 3", pulled)
+	}
+}
@@ -0,0 +1,88 @@
+// Package collections - A least-recently-used cache
+package collections
+
+import "container/list"
+
+// CacheStats counts how a cache has been used
+// A high hit ratio (Hits / (Hits + Misses)) means the cache is earning
+// its memory; many evictions suggest the capacity is too small
+type CacheStats struct {
+	Hits      int // Get calls that found the key
+	Misses    int // Get calls that didn't
+	Evictions int // Entries dropped to make room
+}
+
+// lruEntry is what each list element holds, so an eviction from the
+// back of the list knows which map key to delete
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRUCache holds at most capacity entries; when full, adding a new key
+// evicts the entry that was used least recently
+// A map gives O(1) lookups and a doubly linked list (container/list)
+// keeps entries in recency order, front = most recent, so both Get and
+// Put are O(1). An LRUCache is not safe for concurrent use
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List
+	stats    CacheStats
+}
+
+// NewLRUCache creates an empty cache holding up to capacity entries
+// A non-positive capacity is treated as 1
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value for key and marks it as most recently used
+// Every call counts as a hit or a miss in Stats
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry[K, V]).value, true
+}
+
+// Put stores value under key and marks it as most recently used
+// Adding a new key to a full cache evicts the least recently used entry
+// first; updating an existing key never evicts
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+		c.stats.Evictions++
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+}
+
+// Len returns the number of cached entries
+func (c *LRUCache[K, V]) Len() int {
+	return c.order.Len()
+}
+
+// Stats returns the hit, miss and eviction counts so far
+func (c *LRUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
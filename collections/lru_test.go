@@ -0,0 +1,74 @@
+// Package collections contains tests for the LRUCache type
+package collections
+
+import "testing"
+
+// TestLRUCacheEviction verifies the least recently used entry goes first
+func TestLRUCacheEviction(t *testing.T) {
+	c := NewLRUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")    // "a" is now more recent than "b"
+	c.Put("c", 3) // Evicts "b"
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(\"b\") found an entry that should have been evicted")
+	}
+	for key, want := range map[string]int{"a": 1, "c": 3} {
+		if got, ok := c.Get(key); !ok || got != want {
+			t.Errorf("Get(%q) = %d, %v; want %d, true", key, got, ok, want)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d; want 2", c.Len())
+	}
+}
+
+// TestLRUCacheUpdate verifies Put on an existing key updates without evicting
+func TestLRUCacheUpdate(t *testing.T) {
+	c := NewLRUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 10) // Update, and "a" becomes most recent
+	c.Put("c", 3)  // Evicts "b", not "a"
+
+	if got, ok := c.Get("a"); !ok || got != 10 {
+		t.Errorf("Get(\"a\") = %d, %v; want 10, true", got, ok)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Error("Get(\"b\") found an entry that should have been evicted")
+	}
+}
+
+// TestLRUCacheStats verifies exact counts for a known access pattern
+func TestLRUCacheStats(t *testing.T) {
+	c := NewLRUCache[int, string](2)
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Get(1)          // hit
+	c.Get(3)          // miss
+	c.Put(3, "three") // evicts 2
+	c.Get(2)          // miss
+	c.Put(4, "four")  // evicts 1
+	c.Get(3)          // hit
+	c.Get(4)          // hit
+	c.Put(4, "FOUR")  // update, no eviction
+
+	want := CacheStats{Hits: 3, Misses: 2, Evictions: 2}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats() = %+v; want %+v", got, want)
+	}
+}
+
+// TestLRUCacheCapacity verifies a non-positive capacity still holds one entry
+func TestLRUCacheCapacity(t *testing.T) {
+	c := NewLRUCache[string, int](0)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if c.Len() != 1 {
+		t.Errorf("Len() = %d; want 1", c.Len())
+	}
+	if got, ok := c.Get("b"); !ok || got != 2 {
+		t.Errorf("Get(\"b\") = %d, %v; want 2, true", got, ok)
+	}
+}
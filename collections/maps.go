@@ -0,0 +1,86 @@
+// Package collections - Generic map helpers
+package collections
+
+// InvertMap swaps keys and values: {"one": 1} becomes {1: "one"}
+// Values must be comparable to become keys. If several keys share a
+// value, only one survives: each key overwrites the previous one
+// (last wins), and since map iteration order is random, which key is
+// "last" is unpredictable. Use InvertMapMulti when that matters
+func InvertMap[K comparable, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k // Overwrites on duplicate values
+	}
+	return result
+}
+
+// InvertMapMulti swaps keys and values without losing anything: each
+// value maps to every key that held it
+// The order of keys within each slice follows map iteration, so it is
+// not guaranteed
+func InvertMapMulti[K, V comparable](m map[K]V) map[V][]K {
+	result := make(map[V][]K)
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+	return result
+}
+
+// IndexBy builds a lookup map from keyFn(element) to element, turning
+// repeated linear searches into O(1) map lookups
+// If two elements produce the same key, the later one in s wins
+func IndexBy[T any, K comparable](s []T, keyFn func(T) K) map[K]T {
+	result := make(map[K]T, len(s))
+	for _, item := range s {
+		result[keyFn(item)] = item
+	}
+	return result
+}
+
+// IndexByMulti is like IndexBy but keeps every element that shares a
+// key, in their original order, so nothing is lost on collisions
+func IndexByMulti[T any, K comparable](s []T, keyFn func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for _, item := range s {
+		key := keyFn(item)
+		result[key] = append(result[key], item)
+	}
+	return result
+}
+
+// GetOrCompute returns m[key] if present; otherwise it calls compute,
+// stores the result under key, and returns it
+// This replaces the check-then-set boilerplate of the memoization
+// example in MapPatterns. compute only runs on a miss, so it can be
+// expensive. m must not be nil, since storing into a nil map panics
+func GetOrCompute[K comparable, V any](m map[K]V, key K, compute func() V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	v := compute()
+	m[key] = v
+	return v
+}
+
+// MapEqual reports whether a and b have the same keys with equal values
+// A nil map and an empty map are considered equal, since both hold no
+// entries
+func MapEqual[K, V comparable](a, b map[K]V) bool {
+	return MapEqualFunc(a, b, func(x, y V) bool { return x == y })
+}
+
+// MapEqualFunc is like MapEqual but compares values with eq, so it works
+// for values that == can't compare, such as slices
+func MapEqualFunc[K comparable, V any](a, b map[K]V, eq func(V, V) bool) bool {
+	// Equal lengths plus every key of a found in b means equal key sets
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		vb, ok := b[k]
+		if !ok || !eq(va, vb) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,175 @@
+// Package collections contains tests for the generic map helpers
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestInvertMap verifies keys and values are swapped
+func TestInvertMap(t *testing.T) {
+	got := InvertMap(map[string]int{"one": 1, "two": 2, "three": 3})
+	expected := map[int]string{1: "one", 2: "two", 3: "three"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("InvertMap() = %v; want %v", got, expected)
+	}
+
+	if got := InvertMap(map[string]int{}); len(got) != 0 {
+		t.Errorf("InvertMap(empty) = %v; want empty", got)
+	}
+}
+
+// TestInvertMapCollision verifies one of the colliding keys survives
+func TestInvertMapCollision(t *testing.T) {
+	got := InvertMap(map[string]int{"a": 1, "b": 1, "c": 2})
+	if len(got) != 2 {
+		t.Fatalf("InvertMap() = %v; want 2 entries", got)
+	}
+	if got[1] != "a" && got[1] != "b" {
+		t.Errorf("InvertMap()[1] = %q; want \"a\" or \"b\"", got[1])
+	}
+	if got[2] != "c" {
+		t.Errorf("InvertMap()[2] = %q; want \"c\"", got[2])
+	}
+}
+
+// TestInvertMapMulti verifies colliding keys are all kept
+func TestInvertMapMulti(t *testing.T) {
+	got := InvertMapMulti(map[string]int{"a": 1, "b": 1, "c": 2})
+	expected := map[int][]string{1: {"a", "b"}, 2: {"c"}}
+
+	if len(got) != len(expected) {
+		t.Fatalf("InvertMapMulti() = %v; want %v", got, expected)
+	}
+	for v, keys := range expected {
+		// Key order within a slice depends on map iteration
+		if !EqualUnordered(got[v], keys) {
+			t.Errorf("InvertMapMulti()[%d] = %v; want %v in any order", v, got[v], keys)
+		}
+	}
+}
+
+// user is a small record for the indexing tests
+type user struct {
+	ID   int
+	Name string
+	Team string
+}
+
+// TestIndexBy verifies structs are indexed by a derived key
+func TestIndexBy(t *testing.T) {
+	users := []user{{1, "Ada", "core"}, {2, "Bob", "web"}, {3, "Cy", "core"}}
+
+	byID := IndexBy(users, func(u user) int { return u.ID })
+	expected := map[int]user{1: users[0], 2: users[1], 3: users[2]}
+	if !reflect.DeepEqual(byID, expected) {
+		t.Errorf("IndexBy(ID) = %v; want %v", byID, expected)
+	}
+
+	// Collision: the later element wins
+	byTeam := IndexBy(users, func(u user) string { return u.Team })
+	if len(byTeam) != 2 || byTeam["core"].Name != "Cy" || byTeam["web"].Name != "Bob" {
+		t.Errorf("IndexBy(Team) = %v; want core=Cy, web=Bob", byTeam)
+	}
+
+	if got := IndexBy([]user{}, func(u user) int { return u.ID }); len(got) != 0 {
+		t.Errorf("IndexBy(empty) = %v; want empty", got)
+	}
+}
+
+// TestIndexByMulti verifies colliding elements are kept in order
+func TestIndexByMulti(t *testing.T) {
+	users := []user{{1, "Ada", "core"}, {2, "Bob", "web"}, {3, "Cy", "core"}}
+
+	got := IndexByMulti(users, func(u user) string { return u.Team })
+	expected := map[string][]user{
+		"core": {users[0], users[2]},
+		"web":  {users[1]},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("IndexByMulti(Team) = %v; want %v", got, expected)
+	}
+}
+
+// TestGetOrCompute verifies compute runs only on a miss
+func TestGetOrCompute(t *testing.T) {
+	m := map[string]int{"cached": 1}
+	calls := 0
+	compute := func() int {
+		calls++
+		return 42
+	}
+
+	// A hit returns the stored value without computing
+	if got := GetOrCompute(m, "cached", compute); got != 1 || calls != 0 {
+		t.Errorf("GetOrCompute(hit) = %d with %d calls; want 1 with 0 calls", got, calls)
+	}
+
+	// A miss computes and stores
+	if got := GetOrCompute(m, "new", compute); got != 42 || calls != 1 {
+		t.Errorf("GetOrCompute(miss) = %d with %d calls; want 42 with 1 call", got, calls)
+	}
+	if m["new"] != 42 {
+		t.Errorf("m[\"new\"] = %d; want 42 stored", m["new"])
+	}
+
+	// Later calls reuse the stored value
+	for i := 0; i < 3; i++ {
+		GetOrCompute(m, "new", compute)
+	}
+	if calls != 1 {
+		t.Errorf("compute called %d times; want 1", calls)
+	}
+}
+
+// TestGetOrComputeZeroValue verifies a stored zero value counts as present
+func TestGetOrComputeZeroValue(t *testing.T) {
+	m := map[string]int{"zero": 0}
+	got := GetOrCompute(m, "zero", func() int {
+		t.Error("compute called for a key holding the zero value")
+		return 1
+	})
+	if got != 0 {
+		t.Errorf("GetOrCompute() = %d; want 0", got)
+	}
+}
+
+// TestMapEqual verifies key sets and values are both compared
+func TestMapEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     map[string]int
+		expected bool
+	}{
+		{"equal", map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, true},
+		{"differing values", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}, false},
+		{"differing key sets", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 2}, false},
+		{"extra key", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, false},
+		{"zero value vs missing", map[string]int{"a": 0}, map[string]int{"b": 0}, false},
+		{"nil and empty", nil, map[string]int{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapEqual(tt.a, tt.b); got != tt.expected {
+				t.Errorf("MapEqual(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMapEqualFunc verifies a custom comparison for slice values
+func TestMapEqualFunc(t *testing.T) {
+	sameSlice := func(x, y []int) bool { return reflect.DeepEqual(x, y) }
+
+	a := map[string][]int{"evens": {2, 4}, "odds": {1, 3}}
+	b := map[string][]int{"evens": {2, 4}, "odds": {1, 3}}
+	if !MapEqualFunc(a, b, sameSlice) {
+		t.Errorf("MapEqualFunc(%v, %v) = false; want true", a, b)
+	}
+
+	b["odds"] = []int{1, 5}
+	if MapEqualFunc(a, b, sameSlice) {
+		t.Errorf("MapEqualFunc(%v, %v) = true; want false", a, b)
+	}
+}
@@ -0,0 +1,10 @@
+// Package collections - Generic pair type shared by several helpers
+package collections
+
+// Pair groups two values of possibly different types together
+// Go has no built-in tuple type, so a small generic struct fills that role
+// It is returned by helpers such as Counter.MostCommon and CartesianProduct
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
@@ -0,0 +1,55 @@
+// Package collections - Throttled progress reporting for long loops
+package collections
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// ReportProgress returns a function to call as work completes, passing
+// the number of items done so far. It prints lines such as
+// "progress: 25/100 (25%)" to w, but no more often than once per
+// interval, so a tight loop doesn't flood the output. Completion
+// (done >= total) is always reported, exactly once
+func ReportProgress(total int, interval time.Duration, w io.Writer) func(done int) {
+	return reportProgressWithClock(total, interval, w, time.Now)
+}
+
+// reportProgressWithClock is ReportProgress with an injectable clock
+// Tests pass a fake now function so throttling can be checked without
+// actually sleeping
+func reportProgressWithClock(total int, interval time.Duration, w io.Writer, now func() time.Time) func(done int) {
+	var (
+		lastReport time.Time
+		reported   bool // Whether anything has been printed yet
+		finished   bool // Whether completion has been printed
+	)
+
+	return func(done int) {
+		if finished {
+			return
+		}
+
+		complete := done >= total
+		current := now()
+		// Skip this update if one was printed too recently, unless the
+		// work is complete - the final report must never be lost
+		if reported && !complete && current.Sub(lastReport) < interval {
+			return
+		}
+
+		percent := 100
+		if total > 0 && !complete {
+			percent = done * 100 / total
+		}
+		if complete {
+			done = total
+			finished = true
+		}
+
+		fmt.Fprintf(w, "progress: %d/%d (%d%%)\n", done, total, percent)
+		lastReport = current
+		reported = true
+	}
+}
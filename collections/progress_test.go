@@ -0,0 +1,59 @@
+// Package collections contains tests for the progress reporter
+package collections
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestReportProgressThrottling verifies updates are limited by interval
+func TestReportProgressThrottling(t *testing.T) {
+	var buf bytes.Buffer
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	report := reportProgressWithClock(100, time.Second, &buf, clock.Now)
+
+	report(10) // Printed - first report
+	clock.Advance(300 * time.Millisecond)
+	report(20) // Skipped - too soon
+	clock.Advance(300 * time.Millisecond)
+	report(30) // Skipped - too soon
+	clock.Advance(500 * time.Millisecond)
+	report(40) // Printed - 1.1s since the last report
+
+	expected := "progress: 10/100 (10%)\nprogress: 40/100 (40%)\n"
+	if buf.String() != expected {
+		t.Errorf("output =\n%s\nwant\n%s", buf.String(), expected)
+	}
+}
+
+// TestReportProgressFinalReport verifies completion is always printed once
+func TestReportProgressFinalReport(t *testing.T) {
+	var buf bytes.Buffer
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	report := reportProgressWithClock(50, time.Hour, &buf, clock.Now)
+
+	report(1)
+	report(25) // Throttled
+	report(50) // Completion bypasses throttling
+	report(50) // Already reported
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines %q; want 2", len(lines), lines)
+	}
+	if lines[1] != "progress: 50/50 (100%)" {
+		t.Errorf("final line = %q; want %q", lines[1], "progress: 50/50 (100%)")
+	}
+}
+
+// TestReportProgressRealClock verifies the public constructor works
+func TestReportProgressRealClock(t *testing.T) {
+	var buf bytes.Buffer
+	report := ReportProgress(0, time.Minute, &buf)
+	report(0) // Zero total is complete immediately
+	if buf.String() != "progress: 0/0 (100%)\n" {
+		t.Errorf("output = %q; want %q", buf.String(), "progress: 0/0 (100%)\n")
+	}
+}
@@ -0,0 +1,78 @@
+// Package collections - Randomized helpers with injectable randomness
+package collections
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// randOrDefault returns r, or a time-seeded generator when r is nil
+// Accepting a *rand.Rand lets tests pass a fixed seed for reproducible
+// results while callers who don't care can simply pass nil
+func randOrDefault(r *rand.Rand) *rand.Rand {
+	if r != nil {
+		return r
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// Downsample returns a random subset of exactly target elements from s
+// If s has target elements or fewer, a copy of the whole slice is returned
+// It uses reservoir sampling (Algorithm R): the first target elements fill
+// the reservoir, then each later element i replaces a random slot with
+// probability target/(i+1). Every element ends up equally likely to be
+// chosen, and s is only read once. A nil r uses a time-seeded source
+func Downsample[T any](s []T, target int, r *rand.Rand) []T {
+	if target <= 0 {
+		return []T{}
+	}
+	if len(s) <= target {
+		result := make([]T, len(s))
+		copy(result, s)
+		return result
+	}
+
+	r = randOrDefault(r)
+
+	// Fill the reservoir with the first target elements
+	reservoir := make([]T, target)
+	copy(reservoir, s[:target])
+
+	// Each remaining element may replace a random reservoir slot
+	for i := target; i < len(s); i++ {
+		j := r.Intn(i + 1)
+		if j < target {
+			reservoir[j] = s[i]
+		}
+	}
+	return reservoir
+}
+
+// Shuffle randomly reorders s in place using the Fisher-Yates algorithm
+// Walking backwards, each position i is swapped with a random position
+// in [0, i], which makes every permutation equally likely in O(n) time
+// Passing a seeded r makes the result reproducible; a nil r uses a
+// time-seeded source
+func Shuffle[T any](s []T, r *rand.Rand) {
+	r = randOrDefault(r)
+	for i := len(s) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Sample picks k distinct elements of s uniformly at random
+// "Distinct" means distinct positions: no element of s is picked twice
+// It shares the reservoir sampling approach of Downsample, so s is read
+// once and never copied as a whole. Unlike Downsample, asking for more
+// elements than s holds is an error rather than returning everything
+func Sample[T any](s []T, k int, r *rand.Rand) ([]T, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("sample: k=%d must not be negative", k)
+	}
+	if k > len(s) {
+		return nil, fmt.Errorf("sample: k=%d exceeds slice length %d", k, len(s))
+	}
+	return Downsample(s, k, r), nil
+}
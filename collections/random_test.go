@@ -0,0 +1,144 @@
+// Package collections contains tests for the randomized helpers
+package collections
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestDownsample verifies output size, provenance and determinism
+func TestDownsample(t *testing.T) {
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i * 10
+	}
+
+	// Build a lookup of valid values to check provenance
+	valid := make(map[int]bool)
+	for _, v := range input {
+		valid[v] = true
+	}
+
+	first := Downsample(input, 10, rand.New(rand.NewSource(1)))
+	if len(first) != 10 {
+		t.Fatalf("len(Downsample()) = %d; want 10", len(first))
+	}
+
+	seen := make(map[int]bool)
+	for _, v := range first {
+		if !valid[v] {
+			t.Errorf("Downsample() returned %d, which is not in the input", v)
+		}
+		if seen[v] {
+			t.Errorf("Downsample() returned %d more than once", v)
+		}
+		seen[v] = true
+	}
+
+	// The same seed must produce the same sample
+	second := Downsample(input, 10, rand.New(rand.NewSource(1)))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Downsample() with same seed = %v and %v; want identical", first, second)
+	}
+}
+
+// TestDownsampleShortInput verifies short slices are returned whole
+func TestDownsampleShortInput(t *testing.T) {
+	input := []string{"a", "b", "c"}
+
+	tests := []struct {
+		name     string
+		target   int
+		expected []string
+	}{
+		{"target larger", 5, []string{"a", "b", "c"}},
+		{"target equal", 3, []string{"a", "b", "c"}},
+		{"target zero", 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Downsample(input, tt.target, nil)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Downsample(%v, %d) = %v; want %v", input, tt.target, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestShuffleDeterministic verifies a fixed seed gives a fixed permutation
+func TestShuffleDeterministic(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	Shuffle(s, rand.New(rand.NewSource(42)))
+
+	// math/rand guarantees the same sequence for the same seed
+	expected := []int{5, 6, 8, 4, 1, 7, 3, 2}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Shuffle() with seed 42 = %v; want %v", s, expected)
+	}
+}
+
+// TestShufflePreservesElements verifies no element is lost or duplicated
+func TestShufflePreservesElements(t *testing.T) {
+	s := []string{"a", "b", "c", "d", "e", "f"}
+	counts := NewCounter[string]()
+
+	// A nil source must work too
+	Shuffle(s, nil)
+	for _, v := range s {
+		counts.Add(v)
+	}
+
+	for _, v := range []string{"a", "b", "c", "d", "e", "f"} {
+		if counts.Count(v) != 1 {
+			t.Errorf("after Shuffle(), %q appears %d times; want 1", v, counts.Count(v))
+		}
+	}
+
+	// Shuffling empty and single-element slices must not panic
+	Shuffle([]int{}, nil)
+	Shuffle([]int{1}, nil)
+}
+
+// TestSample verifies determinism, size and distinctness
+func TestSample(t *testing.T) {
+	input := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+
+	first, err := Sample(input, 4, rand.New(rand.NewSource(99)))
+	if err != nil {
+		t.Fatalf("Sample() returned error: %v", err)
+	}
+	second, _ := Sample(input, 4, rand.New(rand.NewSource(99)))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Sample() with same seed = %v and %v; want identical", first, second)
+	}
+
+	if len(first) != 4 {
+		t.Fatalf("len(Sample()) = %d; want 4", len(first))
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range first {
+		if seen[v] {
+			t.Errorf("Sample() returned %q more than once", v)
+		}
+		seen[v] = true
+	}
+
+	// Picking every element returns all of them
+	all, err := Sample(input, len(input), nil)
+	if err != nil || len(all) != len(input) {
+		t.Errorf("Sample(k=len) = %v, %v; want all %d elements", all, err, len(input))
+	}
+}
+
+// TestSampleInvalidK verifies k outside [0, len] returns an error
+func TestSampleInvalidK(t *testing.T) {
+	input := []int{1, 2, 3}
+	for _, k := range []int{4, 100, -1} {
+		if _, err := Sample(input, k, nil); err == nil {
+			t.Errorf("Sample(k=%d) should return an error", k)
+		}
+	}
+}
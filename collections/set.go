@@ -0,0 +1,104 @@
+// Package collections - A generic set built on a map
+package collections
+
+// Set is an unordered collection of unique items
+// It wraps the "map as a set" pattern from MapOperations, using empty
+// struct values because struct{} takes no memory
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet creates a set containing the given items
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add inserts item into the set; adding an existing item has no effect
+func (s *Set[T]) Add(item T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[item] = struct{}{}
+}
+
+// Remove deletes item from the set if present
+func (s *Set[T]) Remove(item T) {
+	delete(s.items, item)
+}
+
+// Contains reports whether item is in the set
+// A nil *Set is treated as empty, so callers may pass nil for "no items"
+func (s *Set[T]) Contains(item T) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of items in the set
+func (s *Set[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.items)
+}
+
+// Items returns the set's items as a slice in no particular order
+func (s *Set[T]) Items() []T {
+	if s == nil {
+		return []T{}
+	}
+	result := make([]T, 0, len(s.items))
+	for item := range s.items {
+		result = append(result, item)
+	}
+	return result
+}
+
+// UnionSlice returns the distinct elements found in a or b
+// Elements keep the order they are first seen in: all of a's, then any
+// new ones from b. These slice-based helpers suit callers who want a
+// stable order, which Set.Items can't give
+func UnionSlice[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	result := make([]T, 0, len(a)+len(b))
+	for _, s := range [][]T{a, b} {
+		for _, v := range s {
+			if _, dup := seen[v]; dup {
+				continue
+			}
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// IntersectSlice returns the distinct elements found in both a and b, in
+// the order they first appear in a
+func IntersectSlice[T comparable](a, b []T) []T {
+	inB := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		inB[v] = struct{}{}
+	}
+
+	result := make([]T, 0)
+	for _, v := range a {
+		if _, ok := inB[v]; ok {
+			result = append(result, v)
+			delete(inB, v) // Deleting also skips later duplicates
+		}
+	}
+	return result
+}
+
+// DifferenceSlice returns the distinct elements of a that are not in b,
+// in the order they first appear in a
+func DifferenceSlice[T comparable](a, b []T) []T {
+	return onlyIn(a, b)
+}
@@ -0,0 +1,101 @@
+// Package collections contains tests for the generic Set type
+package collections
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// TestSetBasics verifies Add, Remove, Contains and Len
+func TestSetBasics(t *testing.T) {
+	s := NewSet("a", "b", "a")
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d; want 2 (duplicates ignored)", s.Len())
+	}
+
+	s.Add("c")
+	s.Remove("a")
+	s.Remove("missing") // Removing a missing item is a no-op
+
+	items := s.Items()
+	sort.Strings(items)
+	if len(items) != 2 || items[0] != "b" || items[1] != "c" {
+		t.Errorf("Items() = %v; want [b c]", items)
+	}
+	if s.Contains("a") {
+		t.Error("Contains(\"a\") = true after Remove; want false")
+	}
+}
+
+// TestSetNil verifies a nil set behaves as empty for read operations
+func TestSetNil(t *testing.T) {
+	var s *Set[int]
+	if s.Contains(1) || s.Len() != 0 || len(s.Items()) != 0 {
+		t.Error("nil Set should behave as an empty set")
+	}
+}
+
+// TestSliceSetOperations verifies union, intersection and difference
+func TestSliceSetOperations(t *testing.T) {
+	tests := []struct {
+		name                 string
+		a, b                 []int
+		union, intersect, ab []int
+	}{
+		{
+			name:      "disjoint",
+			a:         []int{1, 2},
+			b:         []int{3, 4},
+			union:     []int{1, 2, 3, 4},
+			intersect: []int{},
+			ab:        []int{1, 2},
+		},
+		{
+			name:      "overlapping",
+			a:         []int{3, 1, 2},
+			b:         []int{2, 4, 3},
+			union:     []int{3, 1, 2, 4},
+			intersect: []int{3, 2},
+			ab:        []int{1},
+		},
+		{
+			name:      "identical",
+			a:         []int{1, 2, 3},
+			b:         []int{1, 2, 3},
+			union:     []int{1, 2, 3},
+			intersect: []int{1, 2, 3},
+			ab:        []int{},
+		},
+		{
+			name:      "duplicates removed",
+			a:         []int{1, 1, 2, 2},
+			b:         []int{2, 2, 3, 3},
+			union:     []int{1, 2, 3},
+			intersect: []int{2},
+			ab:        []int{1},
+		},
+		{
+			name:      "empty",
+			a:         nil,
+			b:         []int{1},
+			union:     []int{1},
+			intersect: []int{},
+			ab:        []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnionSlice(tt.a, tt.b); !reflect.DeepEqual(got, tt.union) {
+				t.Errorf("UnionSlice(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.union)
+			}
+			if got := IntersectSlice(tt.a, tt.b); !reflect.DeepEqual(got, tt.intersect) {
+				t.Errorf("IntersectSlice(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.intersect)
+			}
+			if got := DifferenceSlice(tt.a, tt.b); !reflect.DeepEqual(got, tt.ab) {
+				t.Errorf("DifferenceSlice(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.ab)
+			}
+		})
+	}
+}
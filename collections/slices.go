@@ -0,0 +1,134 @@
+// Package collections - Generic slice helpers for common patterns
+package collections
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Interleave merges slices round-robin: the first element of each slice,
+// then the second element of each, and so on until all are exhausted
+// Slices that run out early are simply skipped in later rounds
+// The result is always a new slice; the inputs are not modified
+func Interleave[T any](slices ...[]T) []T {
+	// Find the total size and the longest input in one pass
+	total, longest := 0, 0
+	for _, s := range slices {
+		total += len(s)
+		if len(s) > longest {
+			longest = len(s)
+		}
+	}
+
+	result := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, s := range slices {
+			if i < len(s) {
+				result = append(result, s[i])
+			}
+		}
+	}
+	return result
+}
+
+// RunningMax returns a slice where element i is the largest value in
+// s[0..i] (a "prefix maximum"). The result has the same length as s
+// Example: [3 1 4 1 5] becomes [3 3 4 4 5]
+func RunningMax[T cmp.Ordered](s []T) []T {
+	return runningBest(s, func(current, candidate T) bool { return candidate > current })
+}
+
+// RunningMin returns a slice where element i is the smallest value in
+// s[0..i] (a "prefix minimum"). The result has the same length as s
+// Example: [3 1 4 1 5] becomes [3 1 1 1 1]
+func RunningMin[T cmp.Ordered](s []T) []T {
+	return runningBest(s, func(current, candidate T) bool { return candidate < current })
+}
+
+// runningBest carries the best value seen so far across s, replacing it
+// whenever better reports that a new element beats it
+func runningBest[T any](s []T, better func(current, candidate T) bool) []T {
+	result := make([]T, len(s))
+	for i, v := range s {
+		if i == 0 || better(result[i-1], v) {
+			result[i] = v
+		} else {
+			result[i] = result[i-1]
+		}
+	}
+	return result
+}
+
+// ZipLongest pairs up elements of a and b by index, continuing until the
+// longer slice is exhausted. Once the shorter slice runs out, its missing
+// elements are replaced by its fill value (fillA for a, fillB for b)
+// Example: ZipLongest([1 2 3], ["x"], 0, "-") gives (1,x) (2,-) (3,-)
+func ZipLongest[A, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	length := max(len(a), len(b))
+	result := make([]Pair[A, B], length)
+	for i := 0; i < length; i++ {
+		pair := Pair[A, B]{First: fillA, Second: fillB}
+		if i < len(a) {
+			pair.First = a[i]
+		}
+		if i < len(b) {
+			pair.Second = b[i]
+		}
+		result[i] = pair
+	}
+	return result
+}
+
+// WindowReduce slides a window of size elements across s and applies
+// reduce to each window, returning one result per window
+// With size 3, [1 2 3 4] gives reduce([1 2 3]) and reduce([2 3 4]). This
+// generalizes a moving average: pass a sum, max or median instead. If s
+// is shorter than size there are no complete windows and the result is
+// empty. Each window shares memory with s, so reduce must not modify it
+func WindowReduce[T any, R any](s []T, size int, reduce func([]T) R) ([]R, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("window reduce: size=%d must be positive", size)
+	}
+
+	count := max(len(s)-size+1, 0)
+	result := make([]R, count)
+	for i := 0; i < count; i++ {
+		// The three-index slice caps capacity, so an append inside
+		// reduce can't overwrite elements after the window
+		result[i] = reduce(s[i : i+size : i+size])
+	}
+	return result, nil
+}
+
+// Chunk splits s into consecutive pieces of size elements; the last
+// piece is shorter if len(s) isn't a multiple of size
+// Example: Chunk([1 2 3 4 5], 2) gives [[1 2] [3 4] [5]]. The chunks
+// share memory with s rather than copying it
+func Chunk[T any](s []T, size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("chunk: size=%d must be positive", size)
+	}
+
+	chunks := make([][]T, 0, (len(s)+size-1)/size)
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+		chunks = append(chunks, s[start:end:end])
+	}
+	return chunks, nil
+}
+
+// ForEachChunk calls fn on each chunk of s in order, as split by Chunk
+// It stops at the first error, so later chunks are never processed, and
+// returns that error annotated with the chunk's index
+func ForEachChunk[T any](s []T, size int, fn func([]T) error) error {
+	chunks, err := Chunk(s, size)
+	if err != nil {
+		return fmt.Errorf("for each chunk: %w", err)
+	}
+	for i, chunk := range chunks {
+		if err := fn(chunk); err != nil {
+			return fmt.Errorf("for each chunk: chunk %d: %w", i, err)
+		}
+	}
+	return nil
+}
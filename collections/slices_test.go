@@ -0,0 +1,213 @@
+// Package collections contains tests for the generic slice helpers
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestInterleave verifies round-robin merging
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{"equal lengths", [][]int{{1, 2, 3}, {10, 20, 30}}, []int{1, 10, 2, 20, 3, 30}},
+		{"unequal lengths", [][]int{{1}, {10, 20, 30}, {100, 200}}, []int{1, 10, 100, 20, 200, 30}},
+		{"single slice", [][]int{{1, 2, 3}}, []int{1, 2, 3}},
+		{"with empty slice", [][]int{{}, {1, 2}}, []int{1, 2}},
+		{"no slices", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Interleave(tt.input...)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Interleave(%v) = %v; want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRunningMaxMin verifies prefix maximums and minimums
+func TestRunningMaxMin(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []int
+		expectedMax []int
+		expectedMin []int
+	}{
+		{"increasing", []int{1, 2, 3, 4}, []int{1, 2, 3, 4}, []int{1, 1, 1, 1}},
+		{"decreasing", []int{4, 3, 2, 1}, []int{4, 4, 4, 4}, []int{4, 3, 2, 1}},
+		{"mixed", []int{3, 1, 4, 1, 5, 9, 2}, []int{3, 3, 4, 4, 5, 9, 9}, []int{3, 1, 1, 1, 1, 1, 1}},
+		{"single", []int{7}, []int{7}, []int{7}},
+		{"empty", []int{}, []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMax := RunningMax(tt.input)
+			if len(gotMax) != len(tt.input) || !reflect.DeepEqual(gotMax, tt.expectedMax) {
+				t.Errorf("RunningMax(%v) = %v; want %v", tt.input, gotMax, tt.expectedMax)
+			}
+			gotMin := RunningMin(tt.input)
+			if len(gotMin) != len(tt.input) || !reflect.DeepEqual(gotMin, tt.expectedMin) {
+				t.Errorf("RunningMin(%v) = %v; want %v", tt.input, gotMin, tt.expectedMin)
+			}
+		})
+	}
+}
+
+// TestZipLongest verifies pairing and fill values
+func TestZipLongest(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []string
+		expected []Pair[int, string]
+	}{
+		{"equal lengths", []int{1, 2}, []string{"x", "y"}, []Pair[int, string]{{1, "x"}, {2, "y"}}},
+		{"a longer", []int{1, 2, 3}, []string{"x"}, []Pair[int, string]{{1, "x"}, {2, "-"}, {3, "-"}}},
+		{"b longer", []int{1}, []string{"x", "y", "z"}, []Pair[int, string]{{1, "x"}, {-1, "y"}, {-1, "z"}}},
+		{"both empty", nil, nil, []Pair[int, string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ZipLongest(tt.a, tt.b, -1, "-")
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ZipLongest(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestWindowReduce verifies reducers are applied to each sliding window
+func TestWindowReduce(t *testing.T) {
+	sum := func(w []int) int {
+		total := 0
+		for _, v := range w {
+			total += v
+		}
+		return total
+	}
+	windowMax := func(w []int) int {
+		return RunningMax(w)[len(w)-1]
+	}
+
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		reduce   func([]int) int
+		expected []int
+	}{
+		{"window sum", []int{1, 2, 3, 4, 5}, 2, sum, []int{3, 5, 7, 9}},
+		{"window max", []int{1, 3, 2, 5, 4, 1}, 3, windowMax, []int{3, 5, 5, 5}},
+		{"size equals length", []int{1, 2, 3}, 3, sum, []int{6}},
+		{"size one", []int{4, 5}, 1, sum, []int{4, 5}},
+		{"size exceeds length", []int{1, 2}, 3, sum, []int{}},
+		{"empty input", []int{}, 2, sum, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WindowReduce(tt.input, tt.size, tt.reduce)
+			if err != nil {
+				t.Fatalf("WindowReduce(%v, %d) error = %v", tt.input, tt.size, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("WindowReduce(%v, %d) = %v; want %v", tt.input, tt.size, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestWindowReduceInvalidSize verifies non-positive sizes are rejected
+func TestWindowReduceInvalidSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		if _, err := WindowReduce([]int{1, 2}, size, func(w []int) int { return 0 }); err == nil {
+			t.Errorf("WindowReduce(size=%d) error = nil; want an error", size)
+		}
+	}
+}
+
+// TestChunk verifies slices are split at size boundaries
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{"even split", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"short last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"size larger than input", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty input", []int{}, 3, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Chunk(tt.input, tt.size)
+			if err != nil {
+				t.Fatalf("Chunk(%v, %d) error = %v", tt.input, tt.size, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Chunk(%v, %d) = %v; want %v", tt.input, tt.size, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestForEachChunk verifies fn sees each chunk in order
+func TestForEachChunk(t *testing.T) {
+	var seen [][]int
+	err := ForEachChunk([]int{1, 2, 3, 4, 5, 6, 7}, 3, func(chunk []int) error {
+		seen = append(seen, chunk)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEachChunk() error = %v", err)
+	}
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !reflect.DeepEqual(seen, expected) {
+		t.Errorf("ForEachChunk() chunks = %v; want %v", seen, expected)
+	}
+}
+
+// TestForEachChunkStopsOnError verifies later chunks are skipped
+func TestForEachChunkStopsOnError(t *testing.T) {
+	errBoom := errors.New("boom")
+	calls := 0
+	err := ForEachChunk([]int{1, 2, 3, 4, 5, 6}, 2, func(chunk []int) error {
+		calls++
+		if chunk[0] == 3 {
+			return errBoom
+		}
+		return nil
+	})
+
+	if !errors.Is(err, errBoom) {
+		t.Errorf("ForEachChunk() error = %v; want it to wrap %v", err, errBoom)
+	}
+	if calls != 2 {
+		t.Errorf("fn called %d times; want 2 (stop at the failing chunk)", calls)
+	}
+}
+
+// TestForEachChunkInvalidSize verifies non-positive sizes are rejected
+func TestForEachChunkInvalidSize(t *testing.T) {
+	called := false
+	err := ForEachChunk([]int{1}, 0, func([]int) error {
+		called = true
+		return nil
+	})
+	if err == nil || called {
+		t.Errorf("ForEachChunk(size=0) error = %v, called = %v; want an error and no calls", err, called)
+	}
+	if _, err := Chunk([]int{1}, -1); err == nil {
+		t.Error("Chunk(size=-1) error = nil; want an error")
+	}
+}
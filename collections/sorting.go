@@ -0,0 +1,162 @@
+// Package collections - Sorting algorithms implemented on top of slices
+package collections
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// RadixSort sorts non-negative integers using least-significant-digit
+// (LSD) radix sort and returns a new sorted slice
+// Instead of comparing elements, it distributes them into 10 buckets by
+// each decimal digit, starting from the ones place. Running time is
+// O(d * n) where d is the number of digits in the largest value
+// An error is returned if any value is negative
+func RadixSort(s []int) ([]int, error) {
+	// Work on a copy so the caller's slice is left untouched
+	result := make([]int, len(s))
+	copy(result, s)
+
+	// Find the maximum to know how many digit passes are needed
+	maxValue := 0
+	for i, v := range result {
+		if v < 0 {
+			return nil, fmt.Errorf("radix sort: negative value %d at index %d", v, i)
+		}
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+
+	// Scratch buffer reused on every pass
+	buffer := make([]int, len(result))
+
+	// One counting-sort pass per decimal digit
+	for exp := 1; maxValue/exp > 0; exp *= 10 {
+		var counts [10]int
+
+		// Count occurrences of each digit
+		for _, v := range result {
+			counts[(v/exp)%10]++
+		}
+
+		// Turn counts into ending positions (prefix sums)
+		for d := 1; d < 10; d++ {
+			counts[d] += counts[d-1]
+		}
+
+		// Walk backwards so equal digits keep their order (stability)
+		for i := len(result) - 1; i >= 0; i-- {
+			digit := (result[i] / exp) % 10
+			counts[digit]--
+			buffer[counts[digit]] = result[i]
+		}
+
+		// Swap buffers instead of copying
+		result, buffer = buffer, result
+
+		// Guard against overflow of exp for very large values
+		if exp > maxValue/10 {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// BucketSort sorts float64 values in the range [0, 1) and returns a new
+// sorted slice
+// Values are distributed into len(s) buckets by their magnitude, each
+// bucket is sorted with insertion sort (which is stable), and the buckets
+// are concatenated. For uniformly distributed input this runs in O(n)
+// on average. An error is returned for values outside [0, 1)
+func BucketSort(s []float64) ([]float64, error) {
+	n := len(s)
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	// One bucket per element keeps the expected bucket size constant
+	buckets := make([][]float64, n)
+	for i, v := range s {
+		// The negated comparison also rejects NaN
+		if !(v >= 0 && v < 1) {
+			return nil, fmt.Errorf("bucket sort: value %v at index %d is outside [0, 1)", v, i)
+		}
+		index := int(v * float64(n))
+		buckets[index] = append(buckets[index], v)
+	}
+
+	result := make([]float64, 0, n)
+	for _, bucket := range buckets {
+		insertionSort(bucket)
+		result = append(result, bucket...)
+	}
+	return result, nil
+}
+
+// insertionSort sorts a small slice in place
+// Only strictly greater elements are shifted, so equal values keep
+// their original relative order
+func insertionSort(s []float64) {
+	for i := 1; i < len(s); i++ {
+		current := s[i]
+		j := i - 1
+		for j >= 0 && s[j] > current {
+			s[j+1] = s[j]
+			j--
+		}
+		s[j+1] = current
+	}
+}
+
+// KthSmallest returns the k-th smallest element of s, where k is 1-based
+// (k=1 is the minimum, k=len(s) is the maximum)
+// It uses quickselect: like quicksort it partitions around a pivot, but
+// only recurses into the side that contains the answer, giving O(n)
+// average running time. The caller's slice is not modified
+func KthSmallest[T cmp.Ordered](s []T, k int) (T, error) {
+	var zero T
+	if k < 1 || k > len(s) {
+		return zero, fmt.Errorf("kth smallest: k=%d out of range [1, %d]", k, len(s))
+	}
+
+	// Partitioning reorders elements, so work on a copy
+	work := make([]T, len(s))
+	copy(work, s)
+
+	target := k - 1 // Convert to a 0-based index
+	low, high := 0, len(work)-1
+	for low < high {
+		p := partition(work, low, high)
+		switch {
+		case p == target:
+			return work[p], nil
+		case p < target:
+			low = p + 1 // Answer is in the right part
+		default:
+			high = p - 1 // Answer is in the left part
+		}
+	}
+	return work[target], nil
+}
+
+// partition rearranges s[low..high] around a pivot (Lomuto scheme) and
+// returns the pivot's final index. Elements before it are smaller
+// The middle element is used as pivot to avoid worst-case behavior on
+// already sorted input
+func partition[T cmp.Ordered](s []T, low, high int) int {
+	mid := low + (high-low)/2
+	s[mid], s[high] = s[high], s[mid]
+	pivot := s[high]
+
+	i := low
+	for j := low; j < high; j++ {
+		if s[j] < pivot {
+			s[i], s[j] = s[j], s[i]
+			i++
+		}
+	}
+	s[i], s[high] = s[high], s[i]
+	return i
+}
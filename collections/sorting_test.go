@@ -0,0 +1,157 @@
+// Package collections contains tests for the sorting algorithms
+package collections
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestRadixSort compares RadixSort against sort.Ints on random input
+func TestRadixSort(t *testing.T) {
+	// A fixed seed keeps the test reproducible
+	r := rand.New(rand.NewSource(42))
+
+	for _, size := range []int{0, 1, 2, 10, 100, 1000} {
+		input := make([]int, size)
+		for i := range input {
+			input[i] = r.Intn(1_000_000)
+		}
+		original := make([]int, size)
+		copy(original, input)
+
+		got, err := RadixSort(input)
+		if err != nil {
+			t.Fatalf("RadixSort() size %d returned error: %v", size, err)
+		}
+
+		expected := make([]int, size)
+		copy(expected, input)
+		sort.Ints(expected)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("RadixSort() size %d = %v; want %v", size, got, expected)
+		}
+
+		// The input must not be modified
+		if !reflect.DeepEqual(input, original) {
+			t.Errorf("RadixSort() modified its input")
+		}
+	}
+}
+
+// TestRadixSortNegative verifies negative values are rejected
+func TestRadixSortNegative(t *testing.T) {
+	_, err := RadixSort([]int{3, -1, 2})
+	if err == nil {
+		t.Fatal("RadixSort() with negative value should return an error")
+	}
+	if !strings.Contains(err.Error(), "negative") {
+		t.Errorf("Error message = %q, want to contain %q", err.Error(), "negative")
+	}
+}
+
+// TestBucketSort compares BucketSort against sort.Float64s on uniform input
+func TestBucketSort(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	for _, size := range []int{0, 1, 5, 100, 1000} {
+		input := make([]float64, size)
+		for i := range input {
+			input[i] = r.Float64() // Uniform in [0, 1)
+		}
+
+		got, err := BucketSort(input)
+		if err != nil {
+			t.Fatalf("BucketSort() size %d returned error: %v", size, err)
+		}
+
+		expected := make([]float64, size)
+		copy(expected, input)
+		sort.Float64s(expected)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("BucketSort() size %d = %v; want %v", size, got, expected)
+		}
+	}
+}
+
+// TestBucketSortOutOfRange verifies values outside [0, 1) are rejected
+func TestBucketSortOutOfRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+	}{
+		{"exactly one", []float64{0.5, 1.0}},
+		{"negative", []float64{-0.1, 0.2}},
+		{"greater than one", []float64{0.3, 2.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BucketSort(tt.input); err == nil {
+				t.Errorf("BucketSort(%v) should return an error", tt.input)
+			}
+		})
+	}
+}
+
+// TestKthSmallest verifies quickselect against known positions
+func TestKthSmallest(t *testing.T) {
+	input := []int{9, 1, 8, 2, 7, 3, 6, 4, 5}
+	original := make([]int, len(input))
+	copy(original, input)
+
+	tests := []struct {
+		name     string
+		k        int
+		expected int
+	}{
+		{"minimum", 1, 1},
+		{"median", 5, 5},
+		{"maximum", len(input), 9},
+		{"second", 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KthSmallest(input, tt.k)
+			if err != nil {
+				t.Fatalf("KthSmallest(k=%d) returned error: %v", tt.k, err)
+			}
+			if got != tt.expected {
+				t.Errorf("KthSmallest(k=%d) = %d; want %d", tt.k, got, tt.expected)
+			}
+		})
+	}
+
+	// The caller's slice must be unchanged
+	if !reflect.DeepEqual(input, original) {
+		t.Errorf("KthSmallest() modified its input: %v", input)
+	}
+}
+
+// TestKthSmallestDuplicates verifies duplicates are counted individually
+func TestKthSmallestDuplicates(t *testing.T) {
+	input := []string{"b", "a", "b", "c", "a"}
+	expected := []string{"a", "a", "b", "b", "c"}
+	for k := 1; k <= len(input); k++ {
+		got, err := KthSmallest(input, k)
+		if err != nil || got != expected[k-1] {
+			t.Errorf("KthSmallest(k=%d) = %q, %v; want %q", k, got, err, expected[k-1])
+		}
+	}
+}
+
+// TestKthSmallestOutOfRange verifies invalid k values return an error
+func TestKthSmallestOutOfRange(t *testing.T) {
+	input := []int{3, 1, 2}
+	for _, k := range []int{0, -1, 4} {
+		if _, err := KthSmallest(input, k); err == nil {
+			t.Errorf("KthSmallest(k=%d) should return an error", k)
+		}
+	}
+	if _, err := KthSmallest([]int{}, 1); err == nil {
+		t.Errorf("KthSmallest() on empty slice should return an error")
+	}
+}
@@ -0,0 +1,115 @@
+// Package collections - A key-value cache whose entries expire
+package collections
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlEntry pairs a cached value with the moment it stops being valid
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache stores values that expire after a per-entry time to live
+// Expired entries are treated as absent by Get right away, and a
+// background goroutine periodically deletes them so they don't pile up
+// in memory. A mutex makes the cache safe for concurrent use, since the
+// cleanup goroutine runs alongside callers
+type TTLCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]ttlEntry[V]
+	now     func() time.Time // Injectable clock for tests
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTTLCache creates an empty cache that purges expired entries every
+// cleanupInterval. A non-positive interval disables background cleanup
+// (expired entries are still hidden from Get). Call Close when finished
+// to stop the cleanup goroutine
+func NewTTLCache[K comparable, V any](cleanupInterval time.Duration) *TTLCache[K, V] {
+	return newTTLCacheWithClock[K, V](cleanupInterval, time.Now)
+}
+
+// newTTLCacheWithClock is NewTTLCache with an injectable clock
+func newTTLCacheWithClock[K comparable, V any](cleanupInterval time.Duration, now func() time.Time) *TTLCache[K, V] {
+	c := &TTLCache[K, V]{
+		entries: make(map[K]ttlEntry[V]),
+		now:     now,
+		stop:    make(chan struct{}),
+	}
+	if cleanupInterval > 0 {
+		go c.cleanupLoop(cleanupInterval)
+	}
+	return c
+}
+
+// Set stores value under key for the given time to live, replacing any
+// existing entry. A non-positive ttl stores an already expired entry
+func (c *TTLCache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: c.now().Add(ttl)}
+}
+
+// Get returns the value for key if it exists and hasn't expired
+// An entry expires exactly when its TTL has elapsed
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Len returns the number of stored entries, including expired ones that
+// haven't been cleaned up yet
+func (c *TTLCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Close stops the background cleanup goroutine. It is safe to call more
+// than once, and the cache remains usable afterwards
+func (c *TTLCache[K, V]) Close() {
+	c.stopOnce.Do(func() { close(c.stop) })
+}
+
+// cleanupLoop calls cleanup on every tick until Close is called
+func (c *TTLCache[K, V]) cleanupLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.cleanup()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// cleanup deletes every expired entry and returns how many were removed
+// Deleting from a map while ranging over it is allowed in Go
+func (c *TTLCache[K, V]) cleanup() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	removed := 0
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+			removed++
+		}
+	}
+	return removed
+}
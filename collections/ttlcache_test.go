@@ -0,0 +1,79 @@
+// Package collections contains tests for the TTLCache type
+package collections
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTTLCacheExpiresExactly verifies entries expire right at their TTL
+func TestTTLCacheExpiresExactly(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(1000, 0)}
+	cache := newTTLCacheWithClock[string, int](0, clock.Now)
+	defer cache.Close()
+
+	cache.Set("a", 1, 10*time.Second)
+
+	clock.Advance(10*time.Second - time.Nanosecond)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("Get() just before TTL = %d, %v; want 1, true", v, ok)
+	}
+
+	clock.Advance(time.Nanosecond)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Get() at exactly the TTL reported present; want expired")
+	}
+}
+
+// TestTTLCacheOverwriteResetsTTL verifies Set replaces value and expiry
+func TestTTLCacheOverwriteResetsTTL(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	cache := newTTLCacheWithClock[string, string](0, clock.Now)
+
+	cache.Set("k", "old", time.Second)
+	clock.Advance(900 * time.Millisecond)
+	cache.Set("k", "new", time.Second)
+	clock.Advance(900 * time.Millisecond)
+
+	if v, ok := cache.Get("k"); !ok || v != "new" {
+		t.Errorf("Get() = %q, %v; want \"new\", true", v, ok)
+	}
+	if _, ok := cache.Get("missing"); ok {
+		t.Error("Get(\"missing\") reported present")
+	}
+}
+
+// TestTTLCacheCleanup verifies cleanup purges only expired entries
+func TestTTLCacheCleanup(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	cache := newTTLCacheWithClock[int, int](0, clock.Now)
+
+	cache.Set(1, 1, time.Second)
+	cache.Set(2, 2, 5*time.Second)
+	cache.Set(3, 3, time.Minute)
+
+	clock.Advance(5 * time.Second)
+	if removed := cache.cleanup(); removed != 2 {
+		t.Errorf("cleanup() removed %d entries; want 2", removed)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Len() after cleanup = %d; want 1", cache.Len())
+	}
+}
+
+// TestTTLCacheBackgroundCleanup verifies the goroutine purges entries
+func TestTTLCacheBackgroundCleanup(t *testing.T) {
+	cache := NewTTLCache[string, int](5 * time.Millisecond)
+	defer cache.Close()
+
+	cache.Set("short", 1, time.Millisecond)
+
+	deadline := time.Now().Add(time.Second)
+	for cache.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("background cleanup did not remove the expired entry")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cache.Close() // Safe to call twice
+}
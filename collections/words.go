@@ -0,0 +1,40 @@
+// Package collections - Word counting built on maps
+package collections
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WordFrequency counts how many times each word appears in text
+// Words are lowercased and split on anything that isn't a letter or
+// digit, so "Go, go GO!" counts as three occurrences of "go"
+func WordFrequency(text string) map[string]int {
+	return WordFrequencyFiltered(text, nil)
+}
+
+// WordFrequencyFiltered counts words like WordFrequency but skips any word
+// found in stopWords (such as "the", "a", "and")
+// The comparison is case-folded, so a stop word "The" also removes "the"
+// A nil or empty set behaves exactly like WordFrequency
+func WordFrequencyFiltered(text string, stopWords *Set[string]) map[string]int {
+	// Normalize the stop words once instead of on every lookup
+	stop := NewSet[string]()
+	for _, word := range stopWords.Items() {
+		stop.Add(strings.ToLower(word))
+	}
+
+	// FieldsFunc splits wherever the function returns true
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	counts := make(map[string]int)
+	for _, word := range words {
+		if stop.Contains(word) {
+			continue
+		}
+		counts[word]++
+	}
+	return counts
+}
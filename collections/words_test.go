@@ -0,0 +1,52 @@
+// Package collections contains tests for word counting
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestWordFrequency verifies normalization of case and punctuation
+func TestWordFrequency(t *testing.T) {
+	got := WordFrequency("Go, go GO! Gophers love Go.")
+	expected := map[string]int{"go": 4, "gophers": 1, "love": 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("WordFrequency() = %v; want %v", got, expected)
+	}
+}
+
+// TestWordFrequencyFiltered verifies stop words are excluded
+func TestWordFrequencyFiltered(t *testing.T) {
+	text := "The cat and the hat sat on The mat"
+
+	tests := []struct {
+		name      string
+		stopWords *Set[string]
+		expected  map[string]int
+	}{
+		{
+			name:      "stop words removed case-insensitively",
+			stopWords: NewSet("THE", "and", "on"),
+			expected:  map[string]int{"cat": 1, "hat": 1, "sat": 1, "mat": 1},
+		},
+		{
+			name:      "nil set",
+			stopWords: nil,
+			expected:  WordFrequency(text),
+		},
+		{
+			name:      "empty set",
+			stopWords: NewSet[string](),
+			expected:  WordFrequency(text),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WordFrequencyFiltered(text, tt.stopWords)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("WordFrequencyFiltered() = %v; want %v", got, tt.expected)
+			}
+		})
+	}
+}
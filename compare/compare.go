@@ -0,0 +1,116 @@
+// Package compare demonstrates comparing nested values for equality.
+// The == operator only works on comparable types, so slices, maps and
+// structs containing them need a recursive comparison. The standard
+// library's reflect.DeepEqual does this, but it compares floats exactly,
+// which is rarely what you want after arithmetic. This package adds an
+// approximate variant that walks values with reflection.
+package compare
+
+import (
+	"math"
+	"reflect"
+)
+
+// DeepEqual reports whether a and b are deeply equal
+// Slices and arrays are compared element by element, maps key by key,
+// structs field by field, and pointers by what they point to. It is a
+// thin wrapper over reflect.DeepEqual, so the same rules apply: a nil
+// slice is not equal to an empty one, and NaN is never equal to itself
+func DeepEqual(a, b any) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// DeepEqualApprox is like DeepEqual but treats two floating-point values
+// as equal when they differ by at most epsilon
+// The tolerance applies at every depth, so []float64 elements, map
+// values and struct fields are all compared approximately. Everything
+// else follows DeepEqual's rules. Cyclic data structures are not
+// supported and will recurse forever
+func DeepEqualApprox(a, b any, epsilon float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return approxEqual(reflect.ValueOf(a), reflect.ValueOf(b), epsilon)
+}
+
+// approxEqual is the recursive step of DeepEqualApprox
+// reflect.Value accessors such as Float and Int also work on unexported
+// struct fields, which is why they're used instead of Interface
+func approxEqual(a, b reflect.Value, epsilon float64) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		x, y := a.Float(), b.Float()
+		// The x == y check handles matching infinities
+		return x == y || math.Abs(x-y) <= epsilon
+
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		return x == y || (math.Abs(real(x)-real(y)) <= epsilon && math.Abs(imag(x)-imag(y)) <= epsilon)
+
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() {
+			return false
+		}
+		fallthrough
+	case reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !approxEqual(a.Index(i), b.Index(i), epsilon) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			other := b.MapIndex(iter.Key())
+			if !other.IsValid() || !approxEqual(iter.Value(), other, epsilon) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !approxEqual(a.Field(i), b.Field(i), epsilon) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return approxEqual(a.Elem(), b.Elem(), epsilon)
+
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.String:
+		return a.String() == b.String()
+
+	case reflect.Func:
+		// Like reflect.DeepEqual, functions are only equal when both are nil
+		return a.IsNil() && b.IsNil()
+	default:
+		// Channels and unsafe pointers compare by identity
+		return a.Pointer() == b.Pointer()
+	}
+}
@@ -0,0 +1,100 @@
+// Package compare contains tests for the deep-equality helpers
+package compare
+
+import (
+	"math"
+	"testing"
+)
+
+// point has an unexported field to check it is compared too
+type point struct {
+	X, Y  float64
+	label string
+}
+
+// tenth is a variable so 3*tenth is computed at run time with float64
+// rounding rather than folded to an exact constant by the compiler
+var tenth = 0.1
+
+// TestDeepEqual verifies exact comparison of nested values
+func TestDeepEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     any
+		expected bool
+	}{
+		{
+			name:     "nested maps and slices",
+			a:        map[string]any{"tags": []string{"go", "test"}, "meta": map[string]int{"v": 1}},
+			b:        map[string]any{"tags": []string{"go", "test"}, "meta": map[string]int{"v": 1}},
+			expected: true,
+		},
+		{
+			name:     "different nested value",
+			a:        map[string][]int{"a": {1, 2}},
+			b:        map[string][]int{"a": {1, 3}},
+			expected: false,
+		},
+		{"slice order matters", []int{1, 2}, []int{2, 1}, false},
+		{"nil vs empty slice", []int(nil), []int{}, false},
+		{"different types", 1, int64(1), false},
+		{"floats compared exactly", 3 * tenth, 0.3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeepEqual(tt.a, tt.b); got != tt.expected {
+				t.Errorf("DeepEqual(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDeepEqualApprox verifies float tolerance at every depth
+func TestDeepEqualApprox(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     any
+		epsilon  float64
+		expected bool
+	}{
+		{"float within epsilon", 3 * tenth, 0.3, 1e-9, true},
+		{"float beyond epsilon", 1.0, 1.1, 1e-3, false},
+		{"exactly epsilon apart", 1.0, 1.5, 0.5, true},
+		{"float32", float32(1.0), float32(1.0001), 1e-3, true},
+		{"slice within epsilon", []float64{1, 2.0000001}, []float64{1, 2}, 1e-6, true},
+		{"slice beyond epsilon", []float64{1, 2.1}, []float64{1, 2}, 1e-6, false},
+		{
+			name:     "nested map of slices",
+			a:        map[string]any{"xs": []any{1.0, map[string]float64{"y": 2.0000001}}},
+			b:        map[string]any{"xs": []any{1.0, map[string]float64{"y": 2.0}}},
+			epsilon:  1e-6,
+			expected: true,
+		},
+		{
+			name:     "nested map beyond epsilon",
+			a:        map[string]any{"xs": []any{1.0, map[string]float64{"y": 2.5}}},
+			b:        map[string]any{"xs": []any{1.0, map[string]float64{"y": 2.0}}},
+			epsilon:  1e-6,
+			expected: false,
+		},
+		{"missing map key", map[string]float64{"a": 1}, map[string]float64{"b": 1}, 1, false},
+		{"struct fields", point{1, 2, "p"}, point{1.0000001, 2, "p"}, 1e-6, true},
+		{"unexported field differs", point{1, 2, "p"}, point{1, 2, "q"}, 1e-6, false},
+		{"pointers", &point{X: 1}, &point{X: 1.0000001}, 1e-6, true},
+		{"non-float values", []string{"a"}, []string{"b"}, 1, false},
+		{"infinities", math.Inf(1), math.Inf(1), 1e-9, true},
+		{"NaN", math.NaN(), math.NaN(), 1e-9, false},
+		{"different types", 1.0, float32(1.0), 1e-9, false},
+		{"both nil", nil, nil, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeepEqualApprox(tt.a, tt.b, tt.epsilon); got != tt.expected {
+				t.Errorf("DeepEqualApprox(%v, %v, %g) = %v; want %v",
+					tt.a, tt.b, tt.epsilon, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package concurrency - A map that is safe for concurrent use
+package concurrency
+
+import "sync"
+
+// ConcurrentMap wraps a regular map with a sync.RWMutex
+// Go's built-in maps are not safe for concurrent writes: the runtime
+// detects it and crashes with "concurrent map writes". An RWMutex allows
+// many readers at once but only one writer, which suits read-heavy use
+// The zero value is ready to use
+type ConcurrentMap[K comparable, V any] struct {
+	mu    sync.RWMutex
+	items map[K]V
+}
+
+// NewConcurrentMap creates an empty ConcurrentMap
+func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
+	return &ConcurrentMap[K, V]{items: make(map[K]V)}
+}
+
+// Store sets the value for key
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.items == nil {
+		m.items = make(map[K]V)
+	}
+	m.items[key] = value
+}
+
+// Load returns the value for key and whether it was present
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, ok := m.items[key]
+	return value, ok
+}
+
+// Delete removes key from the map if present
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.items, key)
+}
+
+// Len returns the number of entries
+func (m *ConcurrentMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.items)
+}
+
+// Range calls fn for every entry until fn returns false
+// The read lock is held for the whole iteration, so fn must not call
+// Store or Delete on the same map - that would deadlock
+func (m *ConcurrentMap[K, V]) Range(fn func(key K, value V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for key, value := range m.items {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Package concurrency contains tests for ConcurrentMap
+package concurrency
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestConcurrentMapBasics verifies Store, Load, Delete, Len and Range
+func TestConcurrentMapBasics(t *testing.T) {
+	m := NewConcurrentMap[string, int]()
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("a", 10) // Overwrite
+
+	if v, ok := m.Load("a"); !ok || v != 10 {
+		t.Errorf("Load(\"a\") = %d, %v; want 10, true", v, ok)
+	}
+	if _, ok := m.Load("missing"); ok {
+		t.Error("Load(\"missing\") reported present")
+	}
+
+	m.Delete("b")
+	if m.Len() != 1 {
+		t.Errorf("Len() = %d; want 1", m.Len())
+	}
+
+	visited := 0
+	m.Range(func(key string, value int) bool {
+		visited++
+		return true
+	})
+	if visited != 1 {
+		t.Errorf("Range visited %d entries; want 1", visited)
+	}
+}
+
+// TestConcurrentMapRangeStopsEarly verifies returning false ends Range
+func TestConcurrentMapRangeStopsEarly(t *testing.T) {
+	var m ConcurrentMap[int, int] // Zero value must work
+	for i := 0; i < 10; i++ {
+		m.Store(i, i)
+	}
+
+	visited := 0
+	m.Range(func(key, value int) bool {
+		visited++
+		return visited < 3
+	})
+	if visited != 3 {
+		t.Errorf("Range visited %d entries; want 3", visited)
+	}
+}
+
+// TestConcurrentMapStress runs readers and writers at the same time
+// Run with: go test -race ./concurrency
+// A plain map would fail this test under the race detector
+func TestConcurrentMapStress(t *testing.T) {
+	m := NewConcurrentMap[int, int]()
+	const writers, readers, ops = 8, 8, 500
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				key := w*ops + i
+				m.Store(key, i)
+				if i%10 == 0 {
+					m.Delete(key)
+				}
+			}
+		}(w)
+	}
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				m.Load(i)
+				m.Len()
+				m.Range(func(key, value int) bool { return key%7 != 0 })
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Every writer deleted one key in ten
+	expected := writers * (ops - ops/10)
+	if m.Len() != expected {
+		t.Errorf("Len() = %d; want %d", m.Len(), expected)
+	}
+}
@@ -0,0 +1,29 @@
+// Package concurrency - A thread-safe counter using sync/atomic
+package concurrency
+
+import "sync/atomic"
+
+// SafeCounter is a counter that many goroutines can update at once
+// The closure counters in the functions package are not safe for
+// concurrent use: count++ is a read-modify-write that two goroutines can
+// interleave, losing updates. atomic.Int64 performs the whole update as
+// one indivisible CPU operation, with no mutex needed
+// The zero value is ready to use
+type SafeCounter struct {
+	value atomic.Int64
+}
+
+// Inc adds one to the counter
+func (c *SafeCounter) Inc() {
+	c.value.Add(1)
+}
+
+// Add adds delta (which may be negative) to the counter
+func (c *SafeCounter) Add(delta int64) {
+	c.value.Add(delta)
+}
+
+// Value returns the current count
+func (c *SafeCounter) Value() int64 {
+	return c.value.Load()
+}
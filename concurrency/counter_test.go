@@ -0,0 +1,77 @@
+// Package concurrency contains tests and benchmarks for SafeCounter
+package concurrency
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestSafeCounterConcurrent verifies no increments are lost
+func TestSafeCounterConcurrent(t *testing.T) {
+	const goroutines = 100
+	const incrementsEach = 1000
+
+	var counter SafeCounter
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < incrementsEach; j++ {
+				counter.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := counter.Value(), int64(goroutines*incrementsEach); got != want {
+		t.Errorf("Value() = %d; want %d", got, want)
+	}
+}
+
+// TestSafeCounterAdd verifies positive and negative deltas
+func TestSafeCounterAdd(t *testing.T) {
+	var counter SafeCounter
+	counter.Add(10)
+	counter.Add(-3)
+	counter.Inc()
+	if got := counter.Value(); got != 8 {
+		t.Errorf("Value() = %d; want 8", got)
+	}
+}
+
+// mutexCounter is the traditional lock-based alternative used as a
+// baseline in the benchmarks below
+type mutexCounter struct {
+	mu    sync.Mutex
+	value int64
+}
+
+// Inc adds one to the counter while holding the lock
+func (c *mutexCounter) Inc() {
+	c.mu.Lock()
+	c.value++
+	c.mu.Unlock()
+}
+
+// BenchmarkSafeCounter measures atomic increments under contention
+// Run with: go test -bench=Counter ./concurrency
+func BenchmarkSafeCounter(b *testing.B) {
+	var counter SafeCounter
+	// RunParallel spreads b.N iterations across GOMAXPROCS goroutines
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			counter.Inc()
+		}
+	})
+}
+
+// BenchmarkMutexCounter measures mutex-guarded increments under contention
+func BenchmarkMutexCounter(b *testing.B) {
+	var counter mutexCounter
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			counter.Inc()
+		}
+	})
+}
@@ -0,0 +1,80 @@
+// Package concurrency - A publish/subscribe event bus built on channels
+package concurrency
+
+import "sync"
+
+// EventBus delivers every published event to all current subscribers
+// Each subscriber gets its own buffered channel. Publish never blocks:
+// if a subscriber's buffer is full, the event is dropped for that
+// subscriber only (a "drop-on-full" policy). This keeps one slow
+// subscriber from stalling the publisher and everyone else, at the cost
+// of possibly losing events - size the buffer for the expected burst
+type EventBus[T any] struct {
+	mu          sync.RWMutex
+	subscribers []chan T
+	bufferSize  int
+	closed      bool
+}
+
+// NewEventBus creates a bus whose subscriber channels hold up to
+// bufferSize undelivered events. A negative size is treated as 0
+func NewEventBus[T any](bufferSize int) *EventBus[T] {
+	return &EventBus[T]{bufferSize: max(bufferSize, 0)}
+}
+
+// Subscribe returns a channel that receives events published from now on
+// The channel is closed when the bus is closed. Subscribing to a closed
+// bus returns an already closed channel
+func (b *EventBus[T]) Subscribe() <-chan T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan T, b.bufferSize)
+	if b.closed {
+		close(ch)
+		return ch
+	}
+	b.subscribers = append(b.subscribers, ch)
+	return ch
+}
+
+// Publish sends event to every subscriber without blocking
+// It returns how many subscribers received the event; the rest had a
+// full buffer and missed it. Publishing on a closed bus does nothing
+func (b *EventBus[T]) Publish(event T) int {
+	// A read lock is enough: Publish only reads the subscriber list,
+	// and it prevents Close from closing channels mid-send
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return 0
+	}
+
+	delivered := 0
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+			delivered++
+		default:
+			// Buffer full - drop the event for this subscriber
+		}
+	}
+	return delivered
+}
+
+// Close closes every subscriber channel so their range loops end
+// Events already buffered can still be received. Close is idempotent
+func (b *EventBus[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for _, ch := range b.subscribers {
+		close(ch)
+	}
+	b.subscribers = nil
+}
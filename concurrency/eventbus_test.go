@@ -0,0 +1,75 @@
+// Package concurrency contains tests for the EventBus type
+package concurrency
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestEventBusMultipleSubscribers verifies each subscriber gets every event
+func TestEventBusMultipleSubscribers(t *testing.T) {
+	bus := NewEventBus[string](10)
+	subs := []<-chan string{bus.Subscribe(), bus.Subscribe(), bus.Subscribe()}
+
+	for _, event := range []string{"start", "progress", "done"} {
+		if n := bus.Publish(event); n != len(subs) {
+			t.Errorf("Publish(%q) delivered to %d subscribers; want %d", event, n, len(subs))
+		}
+	}
+	bus.Close()
+
+	// Subscribers drain concurrently; range ends when Close closes the channel
+	results := make([][]string, len(subs))
+	var wg sync.WaitGroup
+	for i, sub := range subs {
+		wg.Add(1)
+		go func(i int, sub <-chan string) {
+			defer wg.Done()
+			for event := range sub {
+				results[i] = append(results[i], event)
+			}
+		}(i, sub)
+	}
+	wg.Wait()
+
+	expected := []string{"start", "progress", "done"}
+	for i, got := range results {
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("subscriber %d received %v; want %v", i, got, expected)
+		}
+	}
+}
+
+// TestEventBusDropOnFull verifies a full subscriber doesn't block Publish
+func TestEventBusDropOnFull(t *testing.T) {
+	bus := NewEventBus[int](2)
+	sub := bus.Subscribe()
+
+	for i := 1; i <= 5; i++ {
+		bus.Publish(i) // Must not block even though nobody is reading
+	}
+	bus.Close()
+
+	got := []int{}
+	for v := range sub {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("subscriber received %v; want [1 2] (rest dropped)", got)
+	}
+}
+
+// TestEventBusClose verifies behavior after the bus is closed
+func TestEventBusClose(t *testing.T) {
+	bus := NewEventBus[int](1)
+	bus.Close()
+	bus.Close() // Idempotent
+
+	if n := bus.Publish(1); n != 0 {
+		t.Errorf("Publish() after Close() delivered to %d; want 0", n)
+	}
+	if _, ok := <-bus.Subscribe(); ok {
+		t.Error("Subscribe() after Close() should return a closed channel")
+	}
+}
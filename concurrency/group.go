@@ -0,0 +1,54 @@
+// Package concurrency - Running related goroutines as a group
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// Group runs functions in their own goroutines and collects the first
+// error any of them returns, similar to golang.org/x/sync/errgroup
+// The zero value is ready to use and does not cancel anything; a Group
+// made with GroupWithContext also cancels a shared context as soon as
+// one function fails, so the others can give up early
+type Group struct {
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+	cancel  context.CancelFunc
+}
+
+// GroupWithContext returns a Group and a context derived from ctx
+// The context is canceled the first time a function passed to Go
+// returns an error, or when Wait returns, whichever happens first
+func GroupWithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// Go starts fn in a new goroutine
+// Only the first non-nil error is kept; later errors are dropped
+func (g *Group) Go(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := fn(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				if g.cancel != nil {
+					g.cancel()
+				}
+			})
+		}
+	}()
+}
+
+// Wait blocks until every function started with Go has returned, then
+// returns the first error any of them reported, or nil
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel()
+	}
+	return g.err
+}
@@ -0,0 +1,83 @@
+// Package concurrency contains tests for Group
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestGroupAllSucceed verifies Wait returns nil when nothing fails
+func TestGroupAllSucceed(t *testing.T) {
+	var g Group
+	var ran atomic.Int32
+	for i := 0; i < 5; i++ {
+		g.Go(func() error {
+			ran.Add(1)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		t.Errorf("Wait() = %v; want nil", err)
+	}
+	if got := ran.Load(); got != 5 {
+		t.Errorf("functions run = %d; want 5", got)
+	}
+}
+
+// TestGroupReturnsError verifies Wait reports the failing function's error
+func TestGroupReturnsError(t *testing.T) {
+	errBoom := errors.New("boom")
+	var g Group
+	g.Go(func() error { return nil })
+	g.Go(func() error { return errBoom })
+	g.Go(func() error { return nil })
+
+	if err := g.Wait(); !errors.Is(err, errBoom) {
+		t.Errorf("Wait() = %v; want %v", err, errBoom)
+	}
+}
+
+// TestGroupWaitBlocks verifies Wait does not return until every
+// goroutine has finished, even after one of them has already failed
+func TestGroupWaitBlocks(t *testing.T) {
+	var g Group
+	var finished atomic.Bool
+	g.Go(func() error { return errors.New("fast failure") })
+	g.Go(func() error {
+		time.Sleep(20 * time.Millisecond)
+		finished.Store(true)
+		return nil
+	})
+
+	g.Wait()
+	if !finished.Load() {
+		t.Error("Wait() returned before the slow goroutine finished")
+	}
+}
+
+// TestGroupWithContextCancels verifies a failure cancels the shared
+// context so sibling goroutines can stop early
+func TestGroupWithContextCancels(t *testing.T) {
+	g, ctx := GroupWithContext(context.Background())
+	g.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return errors.New("context was not canceled")
+		}
+	})
+	errBoom := errors.New("boom")
+	g.Go(func() error { return errBoom })
+
+	if err := g.Wait(); !errors.Is(err, errBoom) {
+		t.Errorf("Wait() = %v; want %v", err, errBoom)
+	}
+	if ctx.Err() == nil {
+		t.Error("context not canceled after Wait()")
+	}
+}
@@ -0,0 +1,53 @@
+// Package concurrency - The dining philosophers problem
+package concurrency
+
+import "sync"
+
+// DiningPhilosophers simulates n philosophers sitting around a table with
+// one fork between each neighbor. To eat, a philosopher needs both the
+// fork on their left and the fork on their right. Each one eats rounds
+// times, and the returned slice holds how many times each philosopher ate
+//
+// The naive approach - everyone picks up their left fork first - can
+// deadlock: if all philosophers grab their left fork at the same moment,
+// every right fork is taken and nobody can continue. This version avoids
+// that with ordered locking: every philosopher picks up the lower-numbered
+// fork first. A cycle of waiting is then impossible, because someone
+// always competes for the highest-numbered fork last
+func DiningPhilosophers(n int, rounds int) []int {
+	if n <= 0 {
+		return []int{}
+	}
+
+	forks := make([]sync.Mutex, n)
+	meals := make([]int, n) // Each philosopher only writes their own slot
+
+	var wg sync.WaitGroup
+	for p := 0; p < n; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+
+			left, right := p, (p+1)%n
+			first, second := min(left, right), max(left, right)
+
+			for r := 0; r < rounds; r++ {
+				forks[first].Lock()
+				// With a single philosopher both forks are the same one
+				if second != first {
+					forks[second].Lock()
+				}
+
+				meals[p]++ // Eat
+
+				if second != first {
+					forks[second].Unlock()
+				}
+				forks[first].Unlock()
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	return meals
+}
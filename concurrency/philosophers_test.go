@@ -0,0 +1,41 @@
+// Package concurrency contains tests for the dining philosophers demo
+package concurrency
+
+import (
+	"testing"
+	"time"
+)
+
+// TestDiningPhilosophersTerminates verifies there is no deadlock and
+// every philosopher eats the requested number of times
+func TestDiningPhilosophersTerminates(t *testing.T) {
+	for _, n := range []int{1, 2, 5, 20} {
+		const rounds = 100
+
+		done := make(chan []int)
+		go func() {
+			done <- DiningPhilosophers(n, rounds)
+		}()
+
+		select {
+		case meals := <-done:
+			if len(meals) != n {
+				t.Fatalf("DiningPhilosophers(%d) returned %d counts; want %d", n, len(meals), n)
+			}
+			for p, count := range meals {
+				if count < 1 || count != rounds {
+					t.Errorf("philosopher %d of %d ate %d times; want %d", p, n, count, rounds)
+				}
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("DiningPhilosophers(%d) did not finish - possible deadlock", n)
+		}
+	}
+}
+
+// TestDiningPhilosophersNoPhilosophers verifies n <= 0 returns empty
+func TestDiningPhilosophersNoPhilosophers(t *testing.T) {
+	if meals := DiningPhilosophers(0, 10); len(meals) != 0 {
+		t.Errorf("DiningPhilosophers(0) = %v; want empty", meals)
+	}
+}
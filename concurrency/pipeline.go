@@ -0,0 +1,63 @@
+// Package concurrency - Channel pipelines built from composable stages
+package concurrency
+
+import "context"
+
+// Pipeline connects source to each stage in turn and returns the output
+// of the last stage. Every stage reads from the previous stage's channel
+// and returns a new channel, so data flows through all stages
+// concurrently. With no stages, source itself is returned
+// Stages must close their output channel once their input is closed,
+// which lets the close propagate down the whole pipeline
+func Pipeline[T any](source <-chan T, stages ...func(<-chan T) <-chan T) <-chan T {
+	current := source
+	for _, stage := range stages {
+		current = stage(current)
+	}
+	return current
+}
+
+// Stage turns a plain mapping function into a pipeline stage
+// The returned stage starts a goroutine that applies fn to every value
+// from its input and closes its output when the input is closed
+func Stage[T any](fn func(T) T) func(<-chan T) <-chan T {
+	return func(in <-chan T) <-chan T {
+		out := make(chan T)
+		go func() {
+			defer close(out)
+			for v := range in {
+				out <- fn(v)
+			}
+		}()
+		return out
+	}
+}
+
+// MapStage applies fn to every value from in and sends the results on
+// the returned channel, which may carry a different type than the input
+// Unlike Stage it also watches ctx: once ctx is canceled the goroutine
+// stops reading and sending and closes its output, even if in is still
+// open or nobody is receiving. Values in flight at cancellation may be
+// dropped, so callers should treat a canceled stage's output as partial
+func MapStage[T any, U any](ctx context.Context, in <-chan T, fn func(T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- fn(v):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
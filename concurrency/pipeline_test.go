@@ -0,0 +1,120 @@
+// Package concurrency contains tests for channel pipelines
+package concurrency
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// generate sends values on a new channel and closes it when done
+func generate(values ...int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for _, v := range values {
+			out <- v
+		}
+	}()
+	return out
+}
+
+// collect drains a channel into a slice
+func collect(in <-chan int) []int {
+	result := []int{}
+	for v := range in {
+		result = append(result, v)
+	}
+	return result
+}
+
+// TestPipelineDoubleThenFilter verifies a two-stage pipeline
+func TestPipelineDoubleThenFilter(t *testing.T) {
+	double := Stage(func(n int) int { return n * 2 })
+
+	// A filtering stage is written by hand since it may drop values
+	keepAboveFive := func(in <-chan int) <-chan int {
+		out := make(chan int)
+		go func() {
+			defer close(out)
+			for v := range in {
+				if v > 5 {
+					out <- v
+				}
+			}
+		}()
+		return out
+	}
+
+	got := collect(Pipeline(generate(1, 2, 3, 4, 5), double, keepAboveFive))
+	expected := []int{6, 8, 10}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Pipeline() = %v; want %v", got, expected)
+	}
+}
+
+// TestPipelineNoStages verifies the source passes through unchanged
+func TestPipelineNoStages(t *testing.T) {
+	got := collect(Pipeline(generate(1, 2, 3)))
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Pipeline() = %v; want %v", got, expected)
+	}
+}
+
+// TestMapStageProcessesAll verifies every value is mapped when ctx is
+// never canceled, including a change of type
+func TestMapStageProcessesAll(t *testing.T) {
+	out := MapStage(context.Background(), generate(1, 2, 3), strconv.Itoa)
+
+	got := []string{}
+	for v := range out {
+		got = append(got, v)
+	}
+	expected := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("MapStage() = %v; want %v", got, expected)
+	}
+}
+
+// TestMapStageCanceled verifies the stage stops and closes its output
+// when ctx is canceled part way through an endless input
+func TestMapStageCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// in is never closed, so only cancellation can end the stage
+	in := make(chan int)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case in <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := MapStage(ctx, in, func(n int) int { return n * 2 })
+
+	for i := 0; i < 3; i++ {
+		if got := <-out; got != i*2 {
+			t.Fatalf("MapStage() value %d = %d; want %d", i, got, i*2)
+		}
+	}
+	cancel()
+
+	// Drain anything already in flight; the channel must then close
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-out:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatal("MapStage() output not closed after cancel")
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Package concurrency - Producer-consumer fan-out with clean shutdown
+package concurrency
+
+import "sync"
+
+// ProducerConsumer squares every item using one producer goroutine and
+// several consumer goroutines, then collects the results
+// Results arrive in whatever order the consumers finish, so callers
+// should not rely on the output order. The shutdown sequence is what
+// makes it deadlock-free:
+//  1. the producer closes jobs after sending the last item
+//  2. each consumer's range loop ends once jobs is drained
+//  3. a separate goroutine waits for every consumer, then closes results
+//  4. the collecting loop below ends when results is closed
+//
+// A non-positive consumers count is treated as 1
+func ProducerConsumer(items []int, consumers int) []int {
+	if consumers <= 0 {
+		consumers = 1
+	}
+
+	jobs := make(chan int)
+	results := make(chan int)
+
+	// Producer
+	go func() {
+		defer close(jobs)
+		for _, item := range items {
+			jobs <- item
+		}
+	}()
+
+	// Consumers
+	var wg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range jobs {
+				results <- n * n
+			}
+		}()
+	}
+
+	// Close results only after every consumer has stopped sending
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	collected := make([]int, 0, len(items))
+	for r := range results {
+		collected = append(collected, r)
+	}
+	return collected
+}
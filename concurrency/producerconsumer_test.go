@@ -0,0 +1,30 @@
+// Package concurrency contains tests for the producer-consumer demo
+package concurrency
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// TestProducerConsumer verifies no items are lost or duplicated for
+// any number of consumers; results are sorted since order is not fixed
+func TestProducerConsumer(t *testing.T) {
+	items := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3}
+	expected := []int{1, 1, 4, 9, 9, 16, 25, 25, 36, 81}
+
+	for _, consumers := range []int{-1, 0, 1, 2, 4, 20} {
+		got := ProducerConsumer(items, consumers)
+		sort.Ints(got)
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("ProducerConsumer(items, %d) = %v; want %v", consumers, got, expected)
+		}
+	}
+}
+
+// TestProducerConsumerEmpty verifies empty input shuts down cleanly
+func TestProducerConsumerEmpty(t *testing.T) {
+	if got := ProducerConsumer(nil, 3); len(got) != 0 {
+		t.Errorf("ProducerConsumer(nil, 3) = %v; want []", got)
+	}
+}
@@ -0,0 +1,46 @@
+// Package concurrency - Rate limiting with time.Ticker
+package concurrency
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter lets callers proceed at most once per rate interval
+// Instead of sleeping a fixed amount (like the countdown example), it
+// uses a time.Ticker, which delivers ticks on a channel at a steady pace
+// regardless of how long the work between ticks took
+type RateLimiter struct {
+	ticker   *time.Ticker
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRateLimiter creates a limiter that allows one event per rate
+// Like time.NewTicker, it panics if rate is not positive
+// Call Stop when finished so the ticker's resources are released
+func NewRateLimiter(rate time.Duration) *RateLimiter {
+	return &RateLimiter{
+		ticker: time.NewTicker(rate),
+		done:   make(chan struct{}),
+	}
+}
+
+// Wait blocks until the next tick is available
+// After Stop has been called, Wait returns immediately so no goroutine
+// is left blocked on a ticker that will never fire again
+func (l *RateLimiter) Wait() {
+	select {
+	case <-l.ticker.C:
+	case <-l.done:
+	}
+}
+
+// Stop turns off the ticker and releases any waiting callers
+// It is safe to call Stop more than once
+func (l *RateLimiter) Stop() {
+	l.stopOnce.Do(func() {
+		l.ticker.Stop()
+		close(l.done) // Closing a channel wakes every receiver
+	})
+}
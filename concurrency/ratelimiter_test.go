@@ -0,0 +1,53 @@
+// Package concurrency contains tests for the ticker-based rate limiter
+package concurrency
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRateLimiterPacing verifies N waits take at least (N-1) intervals
+func TestRateLimiterPacing(t *testing.T) {
+	const rate = 10 * time.Millisecond
+	const waits = 5
+
+	limiter := NewRateLimiter(rate)
+	defer limiter.Stop()
+
+	start := time.Now()
+	for i := 0; i < waits; i++ {
+		limiter.Wait()
+	}
+	elapsed := time.Since(start)
+
+	// Timers can fire slightly early or late, so allow a generous tolerance
+	minimum := (waits - 1) * rate
+	tolerance := rate / 2
+	if elapsed < minimum-tolerance {
+		t.Errorf("%d waits took %v; want at least %v", waits, elapsed, minimum)
+	}
+}
+
+// TestRateLimiterStop verifies Stop releases waiters and is idempotent
+func TestRateLimiterStop(t *testing.T) {
+	// A very slow rate means Wait would block for an hour if not released
+	limiter := NewRateLimiter(time.Hour)
+
+	released := make(chan struct{})
+	go func() {
+		limiter.Wait()
+		close(released)
+	}()
+
+	limiter.Stop()
+	limiter.Stop() // Second call must not panic
+
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("Wait() was not released by Stop()")
+	}
+
+	// Wait after Stop returns immediately
+	limiter.Wait()
+}
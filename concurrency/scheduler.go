@@ -0,0 +1,90 @@
+// Package concurrency - Running functions periodically
+package concurrency
+
+import (
+	"sync"
+	"time"
+)
+
+// Scheduler runs functions on a fixed interval in background goroutines
+// It is a tiny cron: each call to Every starts one job, and the returned
+// cancel function stops it. Stop cancels every job at once, which is
+// handy during shutdown. The zero value is ready to use, and a
+// Scheduler is safe for concurrent use
+type Scheduler struct {
+	mu      sync.Mutex
+	nextID  int
+	cancels map[int]func() // Running jobs by ID, so cancel can remove its own entry
+}
+
+// Every calls fn once per interval d, starting one interval from now,
+// until the returned cancel function is called
+// Runs never overlap: if fn takes longer than d, missed ticks are
+// dropped rather than queued (time.Ticker behaves this way). cancel
+// waits for the job's goroutine to exit, so once it returns fn will not
+// run again and nothing is leaked; because of that, fn itself must not
+// call cancel. Calling cancel more than once is safe. Like
+// time.NewTicker, Every panics if d is not positive
+func (s *Scheduler) Every(d time.Duration, fn func()) (cancel func()) {
+	ticker := time.NewTicker(d)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+
+	go func() {
+		defer close(exited)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// A tick and cancel can be ready together, and select
+				// picks randomly, so check done again before running fn
+				select {
+				case <-done:
+					return
+				default:
+				}
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	s.mu.Lock()
+	if s.cancels == nil {
+		s.cancels = make(map[int]func())
+	}
+	id := s.nextID
+	s.nextID++
+
+	var once sync.Once
+	cancel = func() {
+		once.Do(func() {
+			close(done)
+			// Forget the job so a long-lived Scheduler doesn't keep every
+			// canceled job reachable until Stop
+			s.mu.Lock()
+			delete(s.cancels, id)
+			s.mu.Unlock()
+		})
+		<-exited
+	}
+	s.cancels[id] = cancel
+	s.mu.Unlock()
+	return cancel
+}
+
+// Stop cancels every job started with Every and waits for them to exit
+func (s *Scheduler) Stop() {
+	// Copy the jobs out first: each cancel takes s.mu to remove itself
+	s.mu.Lock()
+	cancels := make([]func(), 0, len(s.cancels))
+	for _, cancel := range s.cancels {
+		cancels = append(cancels, cancel)
+	}
+	s.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+}
@@ -0,0 +1,96 @@
+// Package concurrency contains tests for the Scheduler type
+package concurrency
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestSchedulerEvery verifies fn runs roughly once per interval
+func TestSchedulerEvery(t *testing.T) {
+	const interval = 10 * time.Millisecond
+	var s Scheduler
+	var runs atomic.Int64
+
+	cancel := s.Every(interval, func() { runs.Add(1) })
+	time.Sleep(105 * time.Millisecond)
+	cancel()
+
+	// About 10 runs are expected; timers are imprecise on busy machines,
+	// so only check a generous range
+	if got := runs.Load(); got < 3 || got > 11 {
+		t.Errorf("fn ran %d times in ~105ms at a 10ms interval; want about 10", got)
+	}
+}
+
+// TestSchedulerCancelStopsRuns verifies nothing runs after cancel returns
+func TestSchedulerCancelStopsRuns(t *testing.T) {
+	var s Scheduler
+	var runs atomic.Int64
+
+	cancel := s.Every(time.Millisecond, func() { runs.Add(1) })
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	cancel() // Safe to call twice
+
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	if got := runs.Load(); got != stopped {
+		t.Errorf("fn ran %d more times after cancel; want 0", got-stopped)
+	}
+}
+
+// TestSchedulerCancelForgetsJob verifies canceled jobs are removed, so
+// starting and canceling many jobs doesn't grow the Scheduler
+func TestSchedulerCancelForgetsJob(t *testing.T) {
+	var s Scheduler
+	for i := 0; i < 100; i++ {
+		s.Every(time.Hour, func() {})()
+	}
+	keep := s.Every(time.Hour, func() {})
+	defer keep()
+
+	s.mu.Lock()
+	jobs := len(s.cancels)
+	s.mu.Unlock()
+	if jobs != 1 {
+		t.Errorf("Scheduler tracks %d jobs; want 1", jobs)
+	}
+}
+
+// TestSchedulerStop verifies Stop returns promptly, cancels every job
+// and leaves nothing tracked, and that no callback fires afterwards
+func TestSchedulerStop(t *testing.T) {
+	var s Scheduler
+	var runs atomic.Int64
+	for i := 0; i < 5; i++ {
+		s.Every(time.Millisecond, func() { runs.Add(1) })
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	stoppedCh := make(chan struct{})
+	go func() {
+		s.Stop()
+		close(stoppedCh)
+	}()
+	select {
+	case <-stoppedCh:
+	case <-time.After(time.Second):
+		t.Fatal("Stop() did not return")
+	}
+
+	// Stop waits for every job goroutine to exit, so the count is final
+	stopped := runs.Load()
+	time.Sleep(10 * time.Millisecond)
+	if got := runs.Load(); got != stopped {
+		t.Errorf("jobs ran %d more times after Stop; want 0", got-stopped)
+	}
+
+	s.mu.Lock()
+	jobs := len(s.cancels)
+	s.mu.Unlock()
+	if jobs != 0 {
+		t.Errorf("Scheduler tracks %d jobs after Stop; want 0", jobs)
+	}
+}
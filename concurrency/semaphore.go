@@ -0,0 +1,44 @@
+// Package concurrency - Limiting concurrency with a semaphore
+package concurrency
+
+import "context"
+
+// Semaphore limits how many goroutines may hold it at the same time
+// It is built on a buffered channel: acquiring sends a token into the
+// channel and releasing takes one out. Once the buffer is full, further
+// sends block, which is exactly the "wait for a free slot" behavior
+type Semaphore struct {
+	tokens chan struct{}
+}
+
+// NewSemaphore creates a semaphore allowing up to n concurrent holders
+// A non-positive n is treated as 1
+func NewSemaphore(n int) *Semaphore {
+	if n <= 0 {
+		n = 1
+	}
+	return &Semaphore{tokens: make(chan struct{}, n)}
+}
+
+// Acquire blocks until a slot is free or ctx is done
+// If ctx ends first, ctx.Err() is returned and no slot is held, so the
+// caller must not call Release
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s.tokens <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot previously obtained with Acquire
+// Releasing more times than acquiring is a programming error and panics,
+// in the same way sync.Mutex panics when unlocking an unlocked mutex
+func (s *Semaphore) Release() {
+	select {
+	case <-s.tokens:
+	default:
+		panic("semaphore: Release called without a matching Acquire")
+	}
+}
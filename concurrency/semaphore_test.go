@@ -0,0 +1,83 @@
+// Package concurrency contains tests for the Semaphore type
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestSemaphoreLimitsConcurrency verifies at most n holders run at once
+func TestSemaphoreLimitsConcurrency(t *testing.T) {
+	const limit = 3
+	sem := NewSemaphore(limit)
+
+	var active, peak int64
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := sem.Acquire(context.Background()); err != nil {
+				t.Errorf("Acquire() returned error: %v", err)
+				return
+			}
+			defer sem.Release()
+
+			now := atomic.AddInt64(&active, 1)
+			// Record the highest number of simultaneous holders
+			for {
+				old := atomic.LoadInt64(&peak)
+				if now <= old || atomic.CompareAndSwapInt64(&peak, old, now) {
+					break
+				}
+			}
+			time.Sleep(2 * time.Millisecond)
+			atomic.AddInt64(&active, -1)
+		}()
+	}
+	wg.Wait()
+
+	if peak > limit {
+		t.Errorf("peak concurrency = %d; want at most %d", peak, limit)
+	}
+	if peak == 0 {
+		t.Error("no goroutine ever acquired the semaphore")
+	}
+}
+
+// TestSemaphoreAcquireCanceled verifies a canceled context unblocks Acquire
+func TestSemaphoreAcquireCanceled(t *testing.T) {
+	sem := NewSemaphore(1)
+	if err := sem.Acquire(context.Background()); err != nil {
+		t.Fatalf("first Acquire() returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// The only slot is taken, so this must wait until the deadline
+	err := sem.Acquire(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire() error = %v; want %v", err, context.DeadlineExceeded)
+	}
+
+	// After releasing, the slot is available again
+	sem.Release()
+	if err := sem.Acquire(context.Background()); err != nil {
+		t.Errorf("Acquire() after Release() returned error: %v", err)
+	}
+}
+
+// TestSemaphoreReleaseWithoutAcquire verifies the misuse panics
+func TestSemaphoreReleaseWithoutAcquire(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Release() without Acquire() should panic")
+		}
+	}()
+	NewSemaphore(2).Release()
+}
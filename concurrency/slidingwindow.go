@@ -0,0 +1,62 @@
+// Package concurrency - Sliding-window rate limiting
+package concurrency
+
+import (
+	"sync"
+	"time"
+)
+
+// SlidingWindowLimiter allows at most limit events in any window-long
+// stretch of time, the way APIs enforce "100 requests per minute"
+// Unlike RateLimiter, which spaces events evenly, it permits bursts up
+// to the limit. It remembers when each recent event happened, and an
+// event stops counting exactly window after it occurred. Allow never
+// blocks; callers decide whether to drop, queue or retry rejected work
+// A SlidingWindowLimiter is safe for concurrent use
+type SlidingWindowLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	events []time.Time      // Recent event times, oldest first
+	now    func() time.Time // Injectable clock for tests
+}
+
+// NewSlidingWindowLimiter creates a limiter allowing limit events per
+// window. A non-positive limit rejects every event
+func NewSlidingWindowLimiter(limit int, window time.Duration) *SlidingWindowLimiter {
+	return newSlidingWindowLimiterWithClock(limit, window, time.Now)
+}
+
+// newSlidingWindowLimiterWithClock is NewSlidingWindowLimiter with an
+// injectable clock
+func newSlidingWindowLimiterWithClock(limit int, window time.Duration, now func() time.Time) *SlidingWindowLimiter {
+	return &SlidingWindowLimiter{
+		limit:  limit,
+		window: window,
+		events: make([]time.Time, 0, max(limit, 0)),
+		now:    now,
+	}
+}
+
+// Allow reports whether an event may happen now, and if so records it
+// Rejected events are not recorded, so they don't use up the allowance
+func (l *SlidingWindowLimiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	// Drop events that have slid out of the window. They are sorted
+	// oldest first, so stop at the first one still inside
+	cutoff := now.Add(-l.window)
+	expired := 0
+	for expired < len(l.events) && !l.events[expired].After(cutoff) {
+		expired++
+	}
+	l.events = append(l.events[:0], l.events[expired:]...)
+
+	if len(l.events) >= l.limit {
+		return false
+	}
+	l.events = append(l.events, now)
+	return true
+}
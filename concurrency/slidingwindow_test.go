@@ -0,0 +1,94 @@
+// Package concurrency contains tests for the sliding-window limiter
+package concurrency
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestSlidingWindowLimiterRejectsOverLimit verifies the (N+1)th event in
+// a window is rejected
+func TestSlidingWindowLimiterRejectsOverLimit(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	l := newSlidingWindowLimiterWithClock(3, time.Second, clock.Now)
+
+	for i := 1; i <= 3; i++ {
+		if !l.Allow() {
+			t.Errorf("event %d rejected; want allowed", i)
+		}
+		clock.Advance(100 * time.Millisecond)
+	}
+	if l.Allow() {
+		t.Error("event 4 within the window allowed; want rejected")
+	}
+}
+
+// TestSlidingWindowLimiterSlides verifies events count for exactly one
+// window and capacity returns one event at a time
+func TestSlidingWindowLimiterSlides(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	l := newSlidingWindowLimiterWithClock(2, time.Second, clock.Now)
+
+	l.Allow() // t = 0
+	clock.Advance(400 * time.Millisecond)
+	l.Allow() // t = 0.4s
+	if l.Allow() {
+		t.Fatal("third event at t=0.4s allowed; want rejected")
+	}
+
+	// Just before the first event expires there is still no room
+	clock.Advance(600*time.Millisecond - time.Nanosecond)
+	if l.Allow() {
+		t.Error("event just before t=1s allowed; want rejected")
+	}
+
+	// At t = 1s the first event leaves the window, freeing one slot
+	clock.Advance(time.Nanosecond)
+	if !l.Allow() {
+		t.Error("event at t=1s rejected; want allowed")
+	}
+	if l.Allow() {
+		t.Error("second event at t=1s allowed; want rejected (t=0.4s still counts)")
+	}
+
+	// Once the whole window has passed, the full limit is available again
+	clock.Advance(time.Second)
+	if !l.Allow() || !l.Allow() {
+		t.Error("events after a quiet window rejected; want allowed")
+	}
+}
+
+// TestSlidingWindowLimiterZeroLimit verifies a zero limit rejects everything
+func TestSlidingWindowLimiterZeroLimit(t *testing.T) {
+	l := NewSlidingWindowLimiter(0, time.Second)
+	if l.Allow() {
+		t.Error("Allow() with limit 0 = true; want false")
+	}
+}
+
+// TestSlidingWindowLimiterConcurrent verifies the limit holds under
+// concurrent callers (run with -race)
+func TestSlidingWindowLimiterConcurrent(t *testing.T) {
+	l := NewSlidingWindowLimiter(50, time.Hour)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allowed := 0
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if l.Allow() {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed != 50 {
+		t.Errorf("allowed %d of 200 concurrent events; want exactly 50", allowed)
+	}
+}
@@ -0,0 +1,44 @@
+// Package concurrency - Broadcasting channel values to several consumers
+package concurrency
+
+// Tee copies every value from in onto n new output channels, like the
+// Unix tee command. All outputs are closed once in is closed
+// The outputs are unbuffered and each value is delivered to every output
+// before the next value is read, so the slowest consumer sets the pace
+// for everyone. This keeps memory use constant, but it means every output
+// must be drained concurrently (e.g. one goroutine per output); reading
+// one output to the end before touching the others will deadlock
+// A non-positive n returns no outputs and simply drains in
+func Tee[T any](in <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		// Nobody is listening - drain in so its producer isn't stuck
+		go func() {
+			for range in {
+			}
+		}()
+		return []<-chan T{}
+	}
+
+	outs := make([]chan T, n)
+	readOnly := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		readOnly[i] = outs[i] // Callers only get the receive side
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for v := range in {
+			for _, out := range outs {
+				out <- v
+			}
+		}
+	}()
+
+	return readOnly
+}
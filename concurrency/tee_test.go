@@ -0,0 +1,56 @@
+// Package concurrency contains tests for the Tee broadcaster
+package concurrency
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestTeeEveryOutputReceivesAll verifies each output gets the full sequence
+func TestTeeEveryOutputReceivesAll(t *testing.T) {
+	outputs := Tee(generate(1, 2, 3, 4, 5), 3)
+	if len(outputs) != 3 {
+		t.Fatalf("len(Tee()) = %d; want 3", len(outputs))
+	}
+
+	// Drain every output concurrently, as Tee requires
+	results := make([][]int, len(outputs))
+	var wg sync.WaitGroup
+	for i, out := range outputs {
+		wg.Add(1)
+		go func(i int, out <-chan int) {
+			defer wg.Done()
+			// One slow consumer must not break the others
+			if i == 0 {
+				time.Sleep(10 * time.Millisecond)
+			}
+			results[i] = collect(out)
+		}(i, out)
+	}
+	wg.Wait()
+
+	expected := []int{1, 2, 3, 4, 5}
+	for i, got := range results {
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("output %d received %v; want %v", i, got, expected)
+		}
+	}
+}
+
+// TestTeeZeroOutputs verifies n <= 0 drains the input without blocking
+func TestTeeZeroOutputs(t *testing.T) {
+	in := make(chan int)
+	if outputs := Tee(in, 0); len(outputs) != 0 {
+		t.Errorf("len(Tee(in, 0)) = %d; want 0", len(outputs))
+	}
+
+	// This send would block forever if Tee weren't draining in
+	select {
+	case in <- 1:
+	case <-time.After(time.Second):
+		t.Error("Tee(in, 0) did not drain its input")
+	}
+	close(in)
+}
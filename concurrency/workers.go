@@ -0,0 +1,79 @@
+// Package concurrency demonstrates Go's concurrency primitives:
+// goroutines, channels, the sync package, and context cancellation.
+// Each helper is small, generic where useful, and safe to reuse.
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ProcessAll runs fn on every item using a fixed pool of worker goroutines
+// Results are returned in the same order as items, no matter which worker
+// finished first. If any call fails, the shared context is canceled so
+// remaining items are skipped, and the first error encountered is returned
+// together with a nil result slice
+func ProcessAll[T any, R any](items []T, workers int, fn func(T) (R, error)) ([]R, error) {
+	if workers <= 0 {
+		return nil, fmt.Errorf("process all: workers=%d must be positive", workers)
+	}
+
+	// Canceling ctx tells the remaining workers to stop picking up work
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Each worker writes to its own index, so no locking is needed
+	// for the results slice itself
+	results := make([]R, len(items))
+
+	var (
+		firstErr error
+		errOnce  sync.Once
+		wg       sync.WaitGroup
+	)
+
+	// Jobs carry the index so results can be stored in input order
+	jobs := make(chan int)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				// Skip the work if another worker already failed
+				if ctx.Err() != nil {
+					continue
+				}
+				result, err := fn(items[i])
+				if err != nil {
+					// sync.Once guarantees only the first error is kept
+					errOnce.Do(func() {
+						firstErr = fmt.Errorf("process all: item %d: %w", i, err)
+						cancel()
+					})
+					continue
+				}
+				results[i] = result
+			}
+		}()
+	}
+
+	// Feed the jobs, stopping early once the context is canceled
+feed:
+	for i := range items {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs) // Lets the workers' range loops finish
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
@@ -0,0 +1,86 @@
+// Package concurrency contains tests for the worker pool helpers
+package concurrency
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestProcessAllSuccess verifies every item is processed
+func TestProcessAllSuccess(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	results, err := ProcessAll(items, 3, func(n int) (int, error) {
+		return n * n, nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessAll() returned error: %v", err)
+	}
+
+	expected := []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("ProcessAll() = %v; want %v", results, expected)
+	}
+}
+
+// TestProcessAllPreservesOrder verifies results match input order even
+// when later items finish first
+func TestProcessAllPreservesOrder(t *testing.T) {
+	items := []int{50, 40, 30, 20, 10, 0}
+
+	results, err := ProcessAll(items, len(items), func(ms int) (string, error) {
+		// Earlier items sleep longer, so they finish last
+		time.Sleep(time.Duration(ms) * time.Millisecond)
+		return strconv.Itoa(ms), nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessAll() returned error: %v", err)
+	}
+
+	expected := []string{"50", "40", "30", "20", "10", "0"}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("ProcessAll() = %v; want %v", results, expected)
+	}
+}
+
+// TestProcessAllError verifies the first error is returned and remaining
+// work is canceled
+func TestProcessAllError(t *testing.T) {
+	errBoom := errors.New("boom")
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i
+	}
+
+	var calls int64
+	results, err := ProcessAll(items, 2, func(n int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		if n == 3 {
+			return 0, errBoom
+		}
+		time.Sleep(time.Millisecond)
+		return n, nil
+	})
+
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("ProcessAll() error = %v; want %v", err, errBoom)
+	}
+	if results != nil {
+		t.Errorf("ProcessAll() results = %v; want nil on error", results)
+	}
+	if n := atomic.LoadInt64(&calls); n >= int64(len(items)) {
+		t.Errorf("fn called %d times; want remaining work to be canceled", n)
+	}
+}
+
+// TestProcessAllInvalidWorkers verifies a non-positive worker count errors
+func TestProcessAllInvalidWorkers(t *testing.T) {
+	_, err := ProcessAll([]int{1}, 0, func(n int) (int, error) { return n, nil })
+	if err == nil {
+		t.Error("ProcessAll() with 0 workers should return an error")
+	}
+}
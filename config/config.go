@@ -0,0 +1,73 @@
+// Package config loads application settings from environment variables.
+// It grows the "configuration with defaults" pattern from MapPatterns
+// into something usable: values are read once into a map, and typed
+// getters fall back to a default when a key is missing or malformed.
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Config holds the environment variables that matched a prefix
+type Config struct {
+	values map[string]string
+}
+
+// Load reads every environment variable starting with prefix and stores
+// it under its name with the prefix removed. With prefix "APP_", the
+// variable APP_PORT=8080 is available as GetInt("PORT", ...)
+// An empty prefix loads the whole environment
+func Load(prefix string) *Config {
+	c := &Config{values: make(map[string]string)}
+	for _, entry := range os.Environ() {
+		// Each entry looks like "KEY=value"; values may contain '='
+		key, value, found := strings.Cut(entry, "=")
+		if !found || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		c.values[strings.TrimPrefix(key, prefix)] = value
+	}
+	return c
+}
+
+// GetString returns the value for key, or def if it isn't set
+// A variable set to the empty string counts as set
+func (c *Config) GetString(key, def string) string {
+	if value, ok := c.values[key]; ok {
+		return value
+	}
+	return def
+}
+
+// GetInt returns the value for key parsed as an int
+// def is returned if the key is missing or isn't a valid integer, so a
+// typo in the environment can't crash the program
+func (c *Config) GetInt(key string, def int) int {
+	value, ok := c.values[key]
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// GetBool returns the value for key parsed as a bool
+// Accepted values are those of strconv.ParseBool ("1", "t", "true",
+// "0", "f", "false", in any case). def is returned if the key is missing
+// or the value isn't recognized
+func (c *Config) GetBool(key string, def bool) bool {
+	value, ok := c.values[key]
+	if !ok {
+		return def
+	}
+	b, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return def
+	}
+	return b
+}
@@ -0,0 +1,73 @@
+// Package config contains tests for the environment config loader
+package config
+
+import "testing"
+
+// TestLoadAndGetters verifies parsing of string, int and bool values
+func TestLoadAndGetters(t *testing.T) {
+	// t.Setenv calls os.Setenv and restores the old value after the test
+	t.Setenv("MGTEST_HOST", "example.com")
+	t.Setenv("MGTEST_PORT", "8080")
+	t.Setenv("MGTEST_DEBUG", "true")
+	t.Setenv("MGTEST_VERBOSE", "0")
+	t.Setenv("MGTEST_URL", "http://host/?a=b") // Value containing '='
+	t.Setenv("OTHER_PORT", "9999")             // Different prefix
+
+	c := Load("MGTEST_")
+
+	if got := c.GetString("HOST", "localhost"); got != "example.com" {
+		t.Errorf("GetString(HOST) = %q; want %q", got, "example.com")
+	}
+	if got := c.GetString("URL", ""); got != "http://host/?a=b" {
+		t.Errorf("GetString(URL) = %q; want %q", got, "http://host/?a=b")
+	}
+	if got := c.GetInt("PORT", 3000); got != 8080 {
+		t.Errorf("GetInt(PORT) = %d; want 8080", got)
+	}
+	if got := c.GetBool("DEBUG", false); got != true {
+		t.Errorf("GetBool(DEBUG) = %v; want true", got)
+	}
+	if got := c.GetBool("VERBOSE", true); got != false {
+		t.Errorf("GetBool(VERBOSE) = %v; want false", got)
+	}
+}
+
+// TestDefaultsForMissingKeys verifies defaults are used for unset keys
+func TestDefaultsForMissingKeys(t *testing.T) {
+	t.Setenv("OTHER_TIMEOUT", "30") // Not visible through the MGTEST_ prefix
+
+	c := Load("MGTEST_")
+
+	if got := c.GetString("TIMEOUT", "10s"); got != "10s" {
+		t.Errorf("GetString(TIMEOUT) = %q; want default %q", got, "10s")
+	}
+	if got := c.GetInt("TIMEOUT", 10); got != 10 {
+		t.Errorf("GetInt(TIMEOUT) = %d; want default 10", got)
+	}
+	if got := c.GetBool("TIMEOUT", true); got != true {
+		t.Errorf("GetBool(TIMEOUT) = %v; want default true", got)
+	}
+}
+
+// TestMalformedValues verifies bad ints and bools fall back to defaults
+func TestMalformedValues(t *testing.T) {
+	t.Setenv("MGTEST_PORT", "eighty")
+	t.Setenv("MGTEST_DEBUG", "maybe")
+	t.Setenv("MGTEST_EMPTY", "")
+
+	c := Load("MGTEST_")
+
+	if got := c.GetInt("PORT", 3000); got != 3000 {
+		t.Errorf("GetInt(PORT=eighty) = %d; want default 3000", got)
+	}
+	if got := c.GetBool("DEBUG", false); got != false {
+		t.Errorf("GetBool(DEBUG=maybe) = %v; want default false", got)
+	}
+	// An empty string is a set value for GetString, but not a valid int
+	if got := c.GetString("EMPTY", "def"); got != "" {
+		t.Errorf("GetString(EMPTY) = %q; want empty string", got)
+	}
+	if got := c.GetInt("EMPTY", 5); got != 5 {
+		t.Errorf("GetInt(EMPTY) = %d; want default 5", got)
+	}
+}
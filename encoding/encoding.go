@@ -0,0 +1,44 @@
+// Package encoding demonstrates turning bytes into text and back.
+// Binary data (images, hashes, keys) can't always travel as raw bytes,
+// so it is encoded as hexadecimal or base64 text. These helpers are thin
+// wrappers over the standard library that show which package to use.
+package encoding
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// EncodeHex returns data as lowercase hexadecimal, two characters per
+// byte: []byte("Go") becomes "476f"
+func EncodeHex(data []byte) string {
+	return hex.EncodeToString(data)
+}
+
+// DecodeHex converts a hexadecimal string back into bytes
+// Upper and lowercase digits are both accepted. An error is returned for
+// an odd number of characters or non-hex characters
+func DecodeHex(s string) ([]byte, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode hex: %w", err)
+	}
+	return data, nil
+}
+
+// EncodeBase64 returns data in standard, padded base64, which uses
+// 4 characters for every 3 bytes: []byte("Go") becomes "R28="
+func EncodeBase64(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeBase64 converts standard, padded base64 back into bytes
+// An error is returned for invalid characters or incorrect padding
+func DecodeBase64(s string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode base64: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,85 @@
+// Package encoding contains tests for the hex and base64 helpers
+package encoding
+
+import (
+	"bytes"
+	"testing"
+)
+
+// testInputs cover empty, text and binary data
+var testInputs = [][]byte{
+	{},
+	[]byte("Go"),
+	[]byte("Hello, 世界!"),
+	{0x00, 0xff, 0x10, 0x80, 0x7f},
+}
+
+// TestHexKnownValues verifies the encoded form of known inputs
+func TestHexKnownValues(t *testing.T) {
+	if got := EncodeHex([]byte("Go")); got != "476f" {
+		t.Errorf("EncodeHex(\"Go\") = %q; want %q", got, "476f")
+	}
+
+	// Uppercase hex digits decode too
+	got, err := DecodeHex("DEADbeef")
+	if err != nil || !bytes.Equal(got, []byte{0xde, 0xad, 0xbe, 0xef}) {
+		t.Errorf("DecodeHex(\"DEADbeef\") = %x, %v; want deadbeef, nil", got, err)
+	}
+}
+
+// TestHexRoundTrip verifies decoding undoes encoding
+func TestHexRoundTrip(t *testing.T) {
+	for _, input := range testInputs {
+		got, err := DecodeHex(EncodeHex(input))
+		if err != nil || !bytes.Equal(got, input) {
+			t.Errorf("hex round trip of %x = %x, %v", input, got, err)
+		}
+	}
+}
+
+// TestDecodeHexMalformed verifies invalid hex input returns an error
+func TestDecodeHexMalformed(t *testing.T) {
+	for _, input := range []string{"abc", "zz", "12 34", "0x12"} {
+		if _, err := DecodeHex(input); err == nil {
+			t.Errorf("DecodeHex(%q) should return an error", input)
+		}
+	}
+}
+
+// TestBase64KnownValues verifies the encoded form of known inputs
+func TestBase64KnownValues(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"Go", "R28="},
+		{"Gop", "R29w"},
+		{"Gopher", "R29waGVy"},
+	}
+
+	for _, tt := range tests {
+		if got := EncodeBase64([]byte(tt.input)); got != tt.expected {
+			t.Errorf("EncodeBase64(%q) = %q; want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+// TestBase64RoundTrip verifies decoding undoes encoding
+func TestBase64RoundTrip(t *testing.T) {
+	for _, input := range testInputs {
+		got, err := DecodeBase64(EncodeBase64(input))
+		if err != nil || !bytes.Equal(got, input) {
+			t.Errorf("base64 round trip of %x = %x, %v", input, got, err)
+		}
+	}
+}
+
+// TestDecodeBase64Malformed verifies invalid base64 input returns an error
+func TestDecodeBase64Malformed(t *testing.T) {
+	for _, input := range []string{"R28", "R2=8", "!!!!", "R28=="} {
+		if _, err := DecodeBase64(input); err == nil {
+			t.Errorf("DecodeBase64(%q) should return an error", input)
+		}
+	}
+}
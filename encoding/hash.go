@@ -0,0 +1,29 @@
+// Package encoding - Checksums and cryptographic hashes
+package encoding
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"hash/crc32"
+)
+
+// MD5Hex returns the MD5 digest of data as 32 hex characters
+// MD5 is fine for detecting accidental corruption but is broken for
+// security purposes - never use it for passwords or signatures
+func MD5Hex(data []byte) string {
+	sum := md5.Sum(data) // A [16]byte array, not a slice
+	return EncodeHex(sum[:])
+}
+
+// SHA256Hex returns the SHA-256 digest of data as 64 hex characters
+// SHA-256 is a secure cryptographic hash suitable for integrity checks
+func SHA256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return EncodeHex(sum[:])
+}
+
+// CRC32 returns the IEEE CRC-32 checksum of data, the same checksum used
+// by zip and gzip. It is very fast but only detects accidental changes
+func CRC32(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
@@ -0,0 +1,48 @@
+// Package encoding contains tests for the hashing helpers
+package encoding
+
+import "testing"
+
+// TestHashKnownVectors verifies outputs against published test vectors
+func TestHashKnownVectors(t *testing.T) {
+	tests := []struct {
+		input  string
+		md5    string
+		sha256 string
+		crc32  uint32
+	}{
+		{
+			input:  "",
+			md5:    "d41d8cd98f00b204e9800998ecf8427e",
+			sha256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			crc32:  0x00000000,
+		},
+		{
+			input:  "abc",
+			md5:    "900150983cd24fb0d6963f7d28e17f72",
+			sha256: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+			crc32:  0x352441c2,
+		},
+		{
+			input:  "The quick brown fox jumps over the lazy dog",
+			md5:    "9e107d9d372bb6826bd81d3542a419d6",
+			sha256: "d7a8fbb307d7809469ca9abcb0082e4f8d5651e46d3cdb762d02d0bf37c9e592",
+			crc32:  0x414fa339,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			data := []byte(tt.input)
+			if got := MD5Hex(data); got != tt.md5 {
+				t.Errorf("MD5Hex(%q) = %s; want %s", tt.input, got, tt.md5)
+			}
+			if got := SHA256Hex(data); got != tt.sha256 {
+				t.Errorf("SHA256Hex(%q) = %s; want %s", tt.input, got, tt.sha256)
+			}
+			if got := CRC32(data); got != tt.crc32 {
+				t.Errorf("CRC32(%q) = %#08x; want %#08x", tt.input, got, tt.crc32)
+			}
+		})
+	}
+}
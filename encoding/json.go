@@ -0,0 +1,21 @@
+// Package encoding - Pretty-printing values as JSON
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PrettyJSON returns v as indented JSON using two spaces per level
+// Output is stable: struct fields keep their declaration order and map
+// keys are sorted by encoding/json, so the same value always produces the
+// same text (handy for diffs and golden-file tests)
+// Values JSON can't represent, such as channels or functions, return an
+// error
+func PrettyJSON(v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("pretty json: %w", err)
+	}
+	return string(data), nil
+}
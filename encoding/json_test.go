@@ -0,0 +1,60 @@
+// Package encoding contains tests for the JSON pretty-printer
+package encoding
+
+import "testing"
+
+// TestPrettyJSONStruct verifies indentation and field order for a struct
+func TestPrettyJSONStruct(t *testing.T) {
+	type item struct {
+		Name  string   `json:"name"`
+		Price float64  `json:"price"`
+		Tags  []string `json:"tags"`
+	}
+
+	got, err := PrettyJSON(item{Name: "apple", Price: 0.5, Tags: []string{"fruit", "red"}})
+	if err != nil {
+		t.Fatalf("PrettyJSON() returned error: %v", err)
+	}
+
+	expected := `{
+  "name": "apple",
+  "price": 0.5,
+  "tags": [
+    "fruit",
+    "red"
+  ]
+}`
+	if got != expected {
+		t.Errorf("PrettyJSON() =\n%s\nwant\n%s", got, expected)
+	}
+}
+
+// TestPrettyJSONSortedKeys verifies map keys come out sorted
+func TestPrettyJSONSortedKeys(t *testing.T) {
+	got, err := PrettyJSON(map[string]int{"zebra": 1, "apple": 2, "mango": 3})
+	if err != nil {
+		t.Fatalf("PrettyJSON() returned error: %v", err)
+	}
+
+	expected := "{\n  \"apple\": 2,\n  \"mango\": 3,\n  \"zebra\": 1\n}"
+	if got != expected {
+		t.Errorf("PrettyJSON() =\n%s\nwant\n%s", got, expected)
+	}
+}
+
+// TestPrettyJSONError verifies unmarshalable values return an error
+func TestPrettyJSONError(t *testing.T) {
+	inputs := map[string]any{
+		"channel":  make(chan int),
+		"function": func() {},
+		"nested":   map[string]any{"ch": make(chan int)},
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			if got, err := PrettyJSON(input); err == nil {
+				t.Errorf("PrettyJSON(%s) = %q; want an error", name, got)
+			}
+		})
+	}
+}
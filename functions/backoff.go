@@ -0,0 +1,70 @@
+// Package functions - A reusable exponential backoff sequence
+package functions
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff produces a sequence of increasing wait durations
+// Each call to Next doubles the previous delay until it reaches max, so
+// retry loops don't have to track the arithmetic themselves. A Backoff
+// is not safe for concurrent use; give each retry loop its own
+type Backoff struct {
+	base    time.Duration
+	max     time.Duration
+	current time.Duration
+	rng     *rand.Rand // nil means no jitter
+}
+
+// NewExponentialBackoff creates a Backoff that starts at base and doubles
+// on every call to Next, never exceeding max
+// A non-positive max means the delay is effectively uncapped. A base
+// below 1ns is raised to 1ns: zero would never grow, and a negative
+// base would produce negative delays that double toward overflow
+func NewExponentialBackoff(base, max time.Duration) *Backoff {
+	if base < time.Nanosecond {
+		base = time.Nanosecond
+	}
+	if max <= 0 {
+		max = math.MaxInt64
+	}
+	return &Backoff{base: base, max: max}
+}
+
+// WithJitter makes Next randomize each delay using r and returns b so it
+// can be chained after the constructor
+// With jitter a delay d becomes a random value in [d/2, d], which keeps
+// many clients that failed together from retrying in lockstep
+// Passing a seeded source makes the sequence reproducible in tests, and
+// passing nil turns jitter off again
+func (b *Backoff) WithJitter(r *rand.Rand) *Backoff {
+	b.rng = r
+	return b
+}
+
+// Next returns the next delay in the sequence: base, 2*base, 4*base, ...
+// capped at max
+func (b *Backoff) Next() time.Duration {
+	switch {
+	case b.current == 0:
+		b.current = min(b.base, b.max)
+	case b.current > b.max/2:
+		// Doubling would pass the cap (or overflow), so clamp instead
+		b.current = b.max
+	default:
+		b.current *= 2
+	}
+
+	if b.rng == nil || b.current <= 1 {
+		return b.current
+	}
+	half := b.current / 2
+	return half + time.Duration(b.rng.Int63n(int64(b.current-half)+1))
+}
+
+// Reset starts the sequence over so the next call to Next returns base
+func (b *Backoff) Reset() {
+	b.current = 0
+}
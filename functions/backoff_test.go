@@ -0,0 +1,103 @@
+// Package functions contains tests for the Backoff type
+package functions
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// TestBackoffProgression verifies delays double and stop at the cap
+func TestBackoffProgression(t *testing.T) {
+	tests := []struct {
+		name      string
+		base, max time.Duration
+		expected  []time.Duration
+	}{
+		{
+			name:     "doubles then caps",
+			base:     100 * time.Millisecond,
+			max:      time.Second,
+			expected: []time.Duration{100, 200, 400, 800, 1000, 1000},
+		},
+		{
+			name:     "base above max",
+			base:     5 * time.Second,
+			max:      time.Second,
+			expected: []time.Duration{1000, 1000},
+		},
+		{
+			name:     "uncapped",
+			base:     time.Millisecond,
+			max:      0,
+			expected: []time.Duration{1, 2, 4, 8, 16},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewExponentialBackoff(tt.base, tt.max)
+			for i, ms := range tt.expected {
+				want := ms * time.Millisecond
+				if got := b.Next(); got != want {
+					t.Errorf("Next() call %d = %v; want %v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestBackoffNoOverflow verifies an uncapped backoff never goes negative
+func TestBackoffNoOverflow(t *testing.T) {
+	b := NewExponentialBackoff(time.Second, 0)
+	for i := 0; i < 100; i++ {
+		if d := b.Next(); d <= 0 {
+			t.Fatalf("Next() call %d = %v; want a positive duration", i+1, d)
+		}
+	}
+}
+
+// TestBackoffNonPositiveBase verifies a zero or negative base is raised
+// to 1ns, so the sequence is positive and still doubles
+func TestBackoffNonPositiveBase(t *testing.T) {
+	for _, base := range []time.Duration{0, -time.Second} {
+		b := NewExponentialBackoff(base, 0)
+		expected := []time.Duration{1, 2, 4, 8}
+		for i, want := range expected {
+			if got := b.Next(); got != want {
+				t.Errorf("NewExponentialBackoff(%v, 0) Next() call %d = %v; want %v", base, i+1, got, want)
+			}
+		}
+	}
+}
+
+// TestBackoffReset verifies Reset restarts the sequence at base
+func TestBackoffReset(t *testing.T) {
+	b := NewExponentialBackoff(10*time.Millisecond, time.Second)
+	b.Next()
+	b.Next()
+	b.Next()
+	b.Reset()
+	if got := b.Next(); got != 10*time.Millisecond {
+		t.Errorf("Next() after Reset = %v; want %v", got, 10*time.Millisecond)
+	}
+}
+
+// TestBackoffJitter verifies jittered delays stay within [d/2, d] and are
+// reproducible with the same seed
+func TestBackoffJitter(t *testing.T) {
+	plain := NewExponentialBackoff(100*time.Millisecond, time.Second)
+	first := NewExponentialBackoff(100*time.Millisecond, time.Second).WithJitter(rand.New(rand.NewSource(7)))
+	second := NewExponentialBackoff(100*time.Millisecond, time.Second).WithJitter(rand.New(rand.NewSource(7)))
+
+	for i := 0; i < 8; i++ {
+		d := plain.Next()
+		got := first.Next()
+		if got < d/2 || got > d {
+			t.Errorf("jittered Next() call %d = %v; want within [%v, %v]", i+1, got, d/2, d)
+		}
+		if again := second.Next(); again != got {
+			t.Errorf("same seed call %d = %v and %v; want equal", i+1, got, again)
+		}
+	}
+}
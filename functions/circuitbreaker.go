@@ -0,0 +1,146 @@
+// Package functions - Circuit breaker for failing operations
+package functions
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Execute when the breaker is rejecting
+// calls without running them
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the mode a CircuitBreaker is in
+type CircuitState int
+
+const (
+	// StateClosed lets every call through while counting failures
+	StateClosed CircuitState = iota
+	// StateOpen rejects calls until the reset timeout has passed
+	StateOpen
+	// StateHalfOpen lets a single trial call through to probe recovery
+	StateHalfOpen
+)
+
+// String returns the state's name, e.g. for logging
+func (s CircuitState) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops calling an operation that keeps failing
+// Retry is right for brief glitches, but hammering a service that is
+// down only makes things worse. Like an electrical breaker, it "trips"
+// (opens) after maxFailures consecutive failures and then fails fast
+// with ErrCircuitOpen. Once resetTimeout has passed it half-opens and
+// lets one trial call through: success closes the circuit again, and
+// failure re-opens it for another timeout
+// A CircuitBreaker is safe for concurrent use
+type CircuitBreaker struct {
+	mu           sync.Mutex
+	maxFailures  int
+	resetTimeout time.Duration
+	now          func() time.Time // Injectable clock for tests
+
+	state    CircuitState
+	failures int       // Consecutive failures while closed
+	openedAt time.Time // When the circuit last opened
+	trialRun bool      // Whether the half-open trial call is in flight
+}
+
+// NewCircuitBreaker creates a closed breaker that opens after
+// maxFailures consecutive failures (at least 1) and waits resetTimeout
+// before trying again
+func NewCircuitBreaker(maxFailures int, resetTimeout time.Duration) *CircuitBreaker {
+	return newCircuitBreakerWithClock(maxFailures, resetTimeout, time.Now)
+}
+
+// newCircuitBreakerWithClock is NewCircuitBreaker with an injectable clock
+func newCircuitBreakerWithClock(maxFailures int, resetTimeout time.Duration, now func() time.Time) *CircuitBreaker {
+	return &CircuitBreaker{
+		maxFailures:  max(maxFailures, 1),
+		resetTimeout: resetTimeout,
+		now:          now,
+	}
+}
+
+// State returns the breaker's current state
+// An open breaker whose timeout has passed reports StateHalfOpen, since
+// the next call will be let through as a trial
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.refreshState()
+	return cb.state
+}
+
+// Execute runs fn unless the circuit is open, and records the outcome
+// While open (or while a half-open trial is already running) fn is not
+// called and ErrCircuitOpen is returned. Otherwise fn's own error is
+// returned unchanged
+func (cb *CircuitBreaker) Execute(fn func() error) error {
+	if err := cb.beforeCall(); err != nil {
+		return err
+	}
+
+	// fn runs without the lock held so slow calls don't block others
+	err := fn()
+	cb.afterCall(err)
+	return err
+}
+
+// refreshState moves an open breaker to half-open once the timeout is up
+// The caller must hold cb.mu
+func (cb *CircuitBreaker) refreshState() {
+	if cb.state == StateOpen && cb.now().Sub(cb.openedAt) >= cb.resetTimeout {
+		cb.state = StateHalfOpen
+		cb.trialRun = false
+	}
+}
+
+// beforeCall decides whether a call may proceed
+func (cb *CircuitBreaker) beforeCall() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.refreshState()
+	switch cb.state {
+	case StateOpen:
+		return ErrCircuitOpen
+	case StateHalfOpen:
+		if cb.trialRun {
+			return ErrCircuitOpen // Only one trial at a time
+		}
+		cb.trialRun = true
+	}
+	return nil
+}
+
+// afterCall updates the state based on the call's result
+func (cb *CircuitBreaker) afterCall(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err == nil {
+		// Any success (including the half-open trial) closes the circuit
+		cb.state = StateClosed
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.state == StateHalfOpen || cb.failures >= cb.maxFailures {
+		cb.state = StateOpen
+		cb.openedAt = cb.now()
+		cb.failures = 0
+	}
+}
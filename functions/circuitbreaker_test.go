@@ -0,0 +1,115 @@
+// Package functions contains tests for the CircuitBreaker type
+package functions
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// errService is what the failing operation returns
+var errService = errors.New("service unavailable")
+
+// TestCircuitBreakerTransitions drives the breaker through
+// closed -> open -> half-open -> closed
+func TestCircuitBreakerTransitions(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	cb := newCircuitBreakerWithClock(3, 10*time.Second, clock.Now)
+	calls := 0
+	fail := func() error { calls++; return errService }
+	succeed := func() error { calls++; return nil }
+
+	// Closed: failures pass through until the limit is hit
+	for i := 1; i <= 3; i++ {
+		if err := cb.Execute(fail); !errors.Is(err, errService) {
+			t.Fatalf("failure %d: Execute() error = %v; want %v", i, err, errService)
+		}
+	}
+	if cb.State() != StateOpen {
+		t.Fatalf("State() after 3 failures = %v; want open", cb.State())
+	}
+
+	// Open: calls are rejected without running fn
+	calls = 0
+	if err := cb.Execute(succeed); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Execute() while open error = %v; want ErrCircuitOpen", err)
+	}
+	clock.Advance(10*time.Second - time.Nanosecond)
+	if err := cb.Execute(succeed); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Execute() just before timeout error = %v; want ErrCircuitOpen", err)
+	}
+	if calls != 0 {
+		t.Errorf("fn called %d times while open; want 0", calls)
+	}
+
+	// Half-open: the timeout has passed, so one trial goes through
+	clock.Advance(time.Nanosecond)
+	if cb.State() != StateHalfOpen {
+		t.Fatalf("State() after timeout = %v; want half-open", cb.State())
+	}
+	if err := cb.Execute(succeed); err != nil || calls != 1 {
+		t.Errorf("trial Execute() error = %v with %d calls; want nil with 1 call", err, calls)
+	}
+
+	// Closed again
+	if cb.State() != StateClosed {
+		t.Errorf("State() after successful trial = %v; want closed", cb.State())
+	}
+}
+
+// TestCircuitBreakerTrialFailure verifies a failed trial re-opens the
+// circuit for a fresh timeout
+func TestCircuitBreakerTrialFailure(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	cb := newCircuitBreakerWithClock(1, time.Minute, clock.Now)
+
+	cb.Execute(func() error { return errService }) // Opens
+	clock.Advance(time.Minute)
+	cb.Execute(func() error { return errService }) // Trial fails
+
+	if cb.State() != StateOpen {
+		t.Fatalf("State() after failed trial = %v; want open", cb.State())
+	}
+	clock.Advance(time.Minute - time.Second)
+	if cb.State() != StateOpen {
+		t.Errorf("State() before the new timeout = %v; want open", cb.State())
+	}
+	clock.Advance(time.Second)
+	if cb.State() != StateHalfOpen {
+		t.Errorf("State() after the new timeout = %v; want half-open", cb.State())
+	}
+}
+
+// TestCircuitBreakerSuccessResetsCount verifies failures must be
+// consecutive to trip the breaker
+func TestCircuitBreakerSuccessResetsCount(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	cb := newCircuitBreakerWithClock(2, time.Second, clock.Now)
+
+	fail := func() error { return errService }
+	for i := 0; i < 5; i++ {
+		cb.Execute(fail)
+		cb.Execute(func() error { return nil })
+	}
+	if cb.State() != StateClosed {
+		t.Errorf("State() after alternating results = %v; want closed", cb.State())
+	}
+}
+
+// TestCircuitStateString verifies state names
+func TestCircuitStateString(t *testing.T) {
+	tests := []struct {
+		state    CircuitState
+		expected string
+	}{
+		{StateClosed, "closed"},
+		{StateOpen, "open"},
+		{StateHalfOpen, "half-open"},
+		{CircuitState(99), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.state.String(); got != tt.expected {
+			t.Errorf("CircuitState(%d).String() = %q; want %q", int(tt.state), got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Package functions - A defer-like stack of cleanup functions
+package functions
+
+import "errors"
+
+// Cleanup collects cleanup functions and runs them later in reverse
+// order, the same way deferred calls run when a function returns
+// It is useful when resources are acquired in one place but released
+// somewhere else, e.g. a constructor that opens several files and
+// hands a single Close to its caller. The zero value is ready to use
+type Cleanup struct {
+	fns []func() error
+}
+
+// Add registers fn to be called by Run
+// Functions added later run earlier (last in, first out), so a resource
+// is released before anything it depends on
+func (c *Cleanup) Add(fn func() error) {
+	c.fns = append(c.fns, fn)
+}
+
+// Run calls every registered function in LIFO order and clears the
+// stack, so a second Run does nothing
+// A failing function does not stop the others - every cleanup still
+// runs - and all errors are combined with errors.Join, so errors.Is
+// works for each of them. Just as deferred calls still run when one of
+// them panics, a panicking cleanup doesn't stop the rest either: SafeCall
+// turns the panic into one of the joined errors. Run returns nil if
+// nothing failed
+func (c *Cleanup) Run() error {
+	fns := c.fns
+	c.fns = nil
+
+	var errs []error
+	for i := len(fns) - 1; i >= 0; i-- {
+		var err error
+		if panicErr := SafeCall(func() { err = fns[i]() }); panicErr != nil {
+			err = panicErr
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,97 @@
+// Package functions - Tests for the Cleanup stack
+package functions
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestCleanupLIFO verifies functions run in reverse order of Add
+func TestCleanupLIFO(t *testing.T) {
+	var order []string
+	var c Cleanup
+	for _, name := range []string{"open db", "open file", "start server"} {
+		c.Add(func() error {
+			order = append(order, name)
+			return nil
+		})
+	}
+
+	if err := c.Run(); err != nil {
+		t.Fatalf("Run() = %v; want nil", err)
+	}
+	expected := []string{"start server", "open file", "open db"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Run() order = %v; want %v", order, expected)
+	}
+}
+
+// TestCleanupRunsAllOnError verifies every function runs even when some
+// fail, and that all errors are reported
+func TestCleanupRunsAllOnError(t *testing.T) {
+	errFirst := errors.New("first failed")
+	errThird := errors.New("third failed")
+	ran := 0
+
+	var c Cleanup
+	c.Add(func() error { ran++; return errFirst })
+	c.Add(func() error { ran++; return nil })
+	c.Add(func() error { ran++; return errThird })
+
+	err := c.Run()
+	if ran != 3 {
+		t.Errorf("Run() ran %d functions; want 3", ran)
+	}
+	for _, want := range []error{errFirst, errThird} {
+		if !errors.Is(err, want) {
+			t.Errorf("Run() = %v; want it to include %v", err, want)
+		}
+	}
+}
+
+// TestCleanupPanic verifies a panicking cleanup neither stops the others
+// nor escapes Run, and that it is reported as an error
+func TestCleanupPanic(t *testing.T) {
+	var order []string
+	var c Cleanup
+	c.Add(func() error { order = append(order, "first"); return nil })
+	c.Add(func() error { panic("cleanup exploded") })
+	c.Add(func() error { order = append(order, "third"); return nil })
+
+	err := c.Run()
+	expected := []string{"third", "first"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Run() order = %v; want %v", order, expected)
+	}
+	if err == nil || !strings.Contains(err.Error(), "cleanup exploded") {
+		t.Errorf("Run() = %v; want an error containing %q", err, "cleanup exploded")
+	}
+	if err := c.Run(); err != nil {
+		t.Errorf("second Run() = %v; want nil after the stack was cleared", err)
+	}
+}
+
+// TestCleanupRunTwice verifies Run empties the stack
+func TestCleanupRunTwice(t *testing.T) {
+	ran := 0
+	var c Cleanup
+	c.Add(func() error { ran++; return nil })
+
+	c.Run()
+	if err := c.Run(); err != nil {
+		t.Errorf("second Run() = %v; want nil", err)
+	}
+	if ran != 1 {
+		t.Errorf("function ran %d times; want 1", ran)
+	}
+}
+
+// TestCleanupEmpty verifies running an empty Cleanup returns nil
+func TestCleanupEmpty(t *testing.T) {
+	var c Cleanup
+	if err := c.Run(); err != nil {
+		t.Errorf("Run() = %v; want nil", err)
+	}
+}
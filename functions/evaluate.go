@@ -0,0 +1,150 @@
+// Package functions - Evaluating arithmetic expressions
+package functions
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// precedence ranks operators: higher binds tighter
+// 'n' is unary minus, which binds tighter than any binary operator
+var precedence = map[byte]int{
+	'+': 1, '-': 1,
+	'*': 2, '/': 2,
+	'n': 3,
+}
+
+// Evaluate computes the value of an infix expression such as
+// "2 + 3 * (4 - 1)" using the four basic operators and parentheses
+// It uses Dijkstra's shunting-yard algorithm with two stacks: numbers
+// wait on one stack, and operators wait on the other until an operator
+// of lower or equal precedence (or a closing parenthesis) shows they can
+// be applied. * and / bind tighter than + and -, operators of equal
+// precedence apply left to right, and a leading minus negates ("-2",
+// "3 * -(1 + 1)")
+// An error is returned for malformed input (unknown characters,
+// unbalanced parentheses, missing operands) and for division by zero
+func Evaluate(expr string) (float64, error) {
+	var values []float64
+	var ops []byte
+
+	// apply pops one operator and its operands, pushing the result
+	apply := func() error {
+		op := ops[len(ops)-1]
+		ops = ops[:len(ops)-1]
+
+		if op == 'n' {
+			values[len(values)-1] = -values[len(values)-1]
+			return nil
+		}
+
+		a, b := values[len(values)-2], values[len(values)-1]
+		values = values[:len(values)-2]
+		var result float64
+		switch op {
+		case '+':
+			result = a + b
+		case '-':
+			result = a - b
+		case '*':
+			result = a * b
+		case '/':
+			if b == 0 {
+				return fmt.Errorf("evaluate: division by zero")
+			}
+			result = a / b
+		}
+		values = append(values, result)
+		return nil
+	}
+
+	// expectOperand tracks what may come next: a number, "(" or unary
+	// minus when true; a binary operator or ")" when false
+	expectOperand := true
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+
+		case (c >= '0' && c <= '9') || c == '.':
+			if !expectOperand {
+				return 0, fmt.Errorf("evaluate: unexpected number at offset %d", i)
+			}
+			start := i
+			for i < len(expr) && ((expr[i] >= '0' && expr[i] <= '9') || expr[i] == '.') {
+				i++
+			}
+			n, err := strconv.ParseFloat(expr[start:i], 64)
+			if err != nil {
+				return 0, fmt.Errorf("evaluate: invalid number %q at offset %d", expr[start:i], start)
+			}
+			values = append(values, n)
+			expectOperand = false
+
+		case c == '(':
+			if !expectOperand {
+				return 0, fmt.Errorf("evaluate: unexpected '(' at offset %d", i)
+			}
+			ops = append(ops, c)
+			i++
+
+		case c == ')':
+			if expectOperand {
+				return 0, fmt.Errorf("evaluate: unexpected ')' at offset %d", i)
+			}
+			// Apply everything back to the matching "("
+			for len(ops) > 0 && ops[len(ops)-1] != '(' {
+				if err := apply(); err != nil {
+					return 0, err
+				}
+			}
+			if len(ops) == 0 {
+				return 0, fmt.Errorf("evaluate: unmatched ')' at offset %d", i)
+			}
+			ops = ops[:len(ops)-1] // Discard the "("
+			i++
+
+		case c == '+' || c == '-' || c == '*' || c == '/':
+			if expectOperand {
+				// Only + and - make sense as prefixes
+				switch c {
+				case '-':
+					ops = append(ops, 'n')
+				case '+':
+					// Unary plus changes nothing
+				default:
+					return 0, fmt.Errorf("evaluate: unexpected %q at offset %d", c, i)
+				}
+				i++
+				continue
+			}
+			// Apply waiting operators that bind at least as tightly
+			// (equal precedence applies first: left to right)
+			for len(ops) > 0 && ops[len(ops)-1] != '(' && precedence[ops[len(ops)-1]] >= precedence[c] {
+				if err := apply(); err != nil {
+					return 0, err
+				}
+			}
+			ops = append(ops, c)
+			expectOperand = true
+			i++
+
+		default:
+			return 0, fmt.Errorf("evaluate: unexpected character %q at offset %d", c, i)
+		}
+	}
+
+	if expectOperand {
+		return 0, fmt.Errorf("evaluate: expression %q is incomplete", expr)
+	}
+	for len(ops) > 0 {
+		if ops[len(ops)-1] == '(' {
+			return 0, fmt.Errorf("evaluate: unmatched '('")
+		}
+		if err := apply(); err != nil {
+			return 0, err
+		}
+	}
+	return values[0], nil
+}
@@ -0,0 +1,81 @@
+// Package functions contains tests for the expression evaluator
+package functions
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestEvaluate verifies precedence, associativity and parentheses
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected float64
+	}{
+		{"42", 42},
+		{"1 + 2", 3},
+		{"2 + 3 * 4", 14},
+		{"2 * 3 + 4", 10},
+		{"10 - 4 - 3", 3},   // Left to right, not 10 - (4 - 3)
+		{"100 / 10 / 5", 2}, // Left to right
+		{"8 - 2 * 3 / 6", 7},
+		{"(2 + 3) * 4", 20},
+		{"2 * (3 + (4 - 1)) / 3", 4},
+		{"((7))", 7},
+		{"-3 + 5", 2},
+		{"2 * -3", -6},
+		{"-(1 + 2) * 2", -6},
+		{"--4", 4},
+		{"+5", 5},
+		{"1.5 * 4", 6},
+		{".5 + .25", 0.75},
+		{"7 / 2", 3.5},
+		{"  1+2*3  ", 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Evaluate(tt.expr)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error = %v", tt.expr, err)
+			}
+			if got != tt.expected {
+				t.Errorf("Evaluate(%q) = %v; want %v", tt.expr, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestEvaluateErrors verifies malformed input and division by zero
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		expr   string
+		errMsg string
+	}{
+		{"", "incomplete"},
+		{"1 +", "incomplete"},
+		{"(1 + 2", "unmatched '('"},
+		{"1 + 2)", "unmatched ')'"},
+		{"()", "unexpected ')'"},
+		{"1 2", "unexpected number"},
+		{"2 (3)", "unexpected '('"},
+		{"* 3", "unexpected '*'"},
+		{"1 + * 2", "unexpected '*'"},
+		{"1.2.3", "invalid number"},
+		{"2 ^ 3", "unexpected character '^'"},
+		{"1 / 0", "division by zero"},
+		{"4 / (2 - 2)", "division by zero"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Evaluate(tt.expr)
+			if err == nil {
+				t.Fatalf("Evaluate(%q) error = nil; want one containing %q", tt.expr, tt.errMsg)
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Evaluate(%q) error = %q; want it to contain %q", tt.expr, err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// Package functions - Applying a function repeatedly
+package functions
+
+// Iterate applies fn to initial n times and returns the result, so
+// Iterate(x, 3, f) is f(f(f(x)))
+// It is the loop form of a simple recursion: each step feeds its output
+// into the next call. n <= 0 returns initial unchanged
+func Iterate[T any](initial T, n int, fn func(T) T) T {
+	value := initial
+	for i := 0; i < n; i++ {
+		value = fn(value)
+	}
+	return value
+}
+
+// IterateAll is like Iterate but returns every value along the way,
+// starting with initial: [x, f(x), f(f(x)), ...]
+// The slice has n+1 elements and its last element equals
+// Iterate(initial, n, fn). n <= 0 returns just [initial]
+func IterateAll[T any](initial T, n int, fn func(T) T) []T {
+	n = max(n, 0)
+	values := make([]T, 0, n+1)
+	values = append(values, initial)
+	for i := 0; i < n; i++ {
+		values = append(values, fn(values[i]))
+	}
+	return values
+}
@@ -0,0 +1,60 @@
+// Package functions - Tests for Iterate and IterateAll
+package functions
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestIterate verifies repeated doubling and the n <= 0 cases
+func TestIterate(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+
+	tests := []struct {
+		name     string
+		initial  int
+		n        int
+		expected int
+	}{
+		{"double ten times", 1, 10, 1024},
+		{"double once", 3, 1, 6},
+		{"zero times", 5, 0, 5},
+		{"negative times", 5, -2, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Iterate(tt.initial, tt.n, double); got != tt.expected {
+				t.Errorf("Iterate(%d, %d, double) = %d; want %d", tt.initial, tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestIterateAll verifies every intermediate value is returned
+func TestIterateAll(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+
+	tests := []struct {
+		name     string
+		initial  int
+		n        int
+		expected []int
+	}{
+		{"double four times", 1, 4, []int{1, 2, 4, 8, 16}},
+		{"zero times", 7, 0, []int{7}},
+		{"negative times", 7, -1, []int{7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IterateAll(tt.initial, tt.n, double)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("IterateAll(%d, %d, double) = %v; want %v", tt.initial, tt.n, got, tt.expected)
+			}
+			if last := got[len(got)-1]; last != Iterate(tt.initial, tt.n, double) {
+				t.Errorf("IterateAll() last = %d; want Iterate() = %d", last, Iterate(tt.initial, tt.n, double))
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Package functions - Caching results of pure functions
+package functions
+
+import "sync"
+
+// Memoize2 wraps a two-argument function so each distinct (a, b) pair is
+// computed only once; later calls with the same arguments return the
+// cached result
+// The cache key is a struct holding both arguments, which is comparable
+// because A and B are, so no string formatting or manual hashing is
+// needed. fn must be pure: its result should depend only on its
+// arguments. The cache is safe for concurrent use and is not locked
+// while fn runs, so fn may call the memoized function recursively
+func Memoize2[A, B comparable, R any](fn func(A, B) R) func(A, B) R {
+	type key struct {
+		a A
+		b B
+	}
+	var (
+		mu    sync.Mutex
+		cache = make(map[key]R)
+	)
+
+	return func(a A, b B) R {
+		k := key{a, b}
+		mu.Lock()
+		r, ok := cache[k]
+		mu.Unlock()
+		if ok {
+			return r
+		}
+
+		// Two goroutines may both miss and compute the same value; since fn
+		// is pure they store identical results, so this is harmless
+		r = fn(a, b)
+		mu.Lock()
+		cache[k] = r
+		mu.Unlock()
+		return r
+	}
+}
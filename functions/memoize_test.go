@@ -0,0 +1,75 @@
+// Package functions - Tests for memoization helpers
+package functions
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestMemoize2Caches verifies fn runs once per distinct argument pair
+func TestMemoize2Caches(t *testing.T) {
+	calls := 0
+	repeat := Memoize2(func(s string, n int) string {
+		calls++
+		return strings.Repeat(s, n)
+	})
+
+	tests := []struct {
+		s         string
+		n         int
+		expected  string
+		wantCalls int
+	}{
+		{"ab", 2, "abab", 1},
+		{"ab", 2, "abab", 1}, // cached
+		{"ab", 3, "ababab", 2},
+		{"x", 2, "xx", 3},
+		{"ab", 3, "ababab", 3}, // cached
+		{"x", 2, "xx", 3},      // cached
+	}
+
+	for _, tt := range tests {
+		if got := repeat(tt.s, tt.n); got != tt.expected {
+			t.Errorf("repeat(%q, %d) = %q; want %q", tt.s, tt.n, got, tt.expected)
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("after repeat(%q, %d) calls = %d; want %d", tt.s, tt.n, calls, tt.wantCalls)
+		}
+	}
+}
+
+// TestMemoize2Recursive verifies a recursive function can use its own
+// memoized version, turning exponential work into one call per cell
+func TestMemoize2Recursive(t *testing.T) {
+	// gridPaths counts the monotone paths across a rows x cols grid
+	calls := 0
+	var gridPaths func(rows, cols int) int
+	gridPaths = Memoize2(func(rows, cols int) int {
+		calls++
+		if rows == 0 || cols == 0 {
+			return 1
+		}
+		return gridPaths(rows-1, cols) + gridPaths(rows, cols-1)
+	})
+
+	tests := []struct {
+		rows, cols int
+		expected   int
+	}{
+		{0, 0, 1},
+		{1, 1, 2},
+		{2, 2, 6},
+		{3, 2, 10},
+		{16, 16, 601080390},
+	}
+
+	for _, tt := range tests {
+		if got := gridPaths(tt.rows, tt.cols); got != tt.expected {
+			t.Errorf("gridPaths(%d, %d) = %d; want %d", tt.rows, tt.cols, got, tt.expected)
+		}
+	}
+	// Each (rows, cols) pair is computed at most once
+	if maxCalls := 17 * 17; calls > maxCalls {
+		t.Errorf("fn called %d times; want at most %d", calls, maxCalls)
+	}
+}
@@ -0,0 +1,91 @@
+// Package functions - Numeric algorithms
+package functions
+
+import (
+	"fmt"
+	"math"
+)
+
+// EvalPolynomial evaluates c0 + c1*x + c2*x² + ... where coeffs[i] is
+// the coefficient of x^i, so coeffs[0] is the constant term
+// It uses Horner's method, rewriting the polynomial as
+// c0 + x*(c1 + x*(c2 + ...)) and working from the innermost term out.
+// That takes one multiply and one add per coefficient with no powers,
+// and accumulates less rounding error than summing separate terms
+// An empty coeffs slice is the zero polynomial and returns 0
+func EvalPolynomial(coeffs []float64, x float64) float64 {
+	result := 0.0
+	// Start at the highest power and work down to the constant term
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		result = result*x + coeffs[i]
+	}
+	return result
+}
+
+// Integrate approximates the definite integral of f from a to b with the
+// trapezoidal rule
+// The interval is cut into n equal slices, and each slice's area is
+// taken as a trapezoid joining f at its two ends. More slices give a
+// better answer; the rule is exact for straight lines. f is passed in
+// as a value, just like the operation in HigherOrderFunction
+// An error is returned when n <= 0 or a > b
+func Integrate(f func(float64) float64, a, b float64, n int) (float64, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("integrate: n=%d must be positive", n)
+	}
+	if a > b {
+		return 0, fmt.Errorf("integrate: lower bound %v is greater than upper bound %v", a, b)
+	}
+
+	h := (b - a) / float64(n)
+	// The end points count half, since each belongs to one trapezoid
+	sum := (f(a) + f(b)) / 2
+	for i := 1; i < n; i++ {
+		sum += f(a + float64(i)*h)
+	}
+	return sum * h, nil
+}
+
+// FindRoot searches for x where f(x) = 0 using Newton's method, given
+// the derivative df and a starting guess
+// Each step follows the tangent line at the current guess down to where
+// it crosses zero: x = x - f(x)/df(x). Near a simple root the number of
+// correct digits roughly doubles per step. It runs at most iters steps
+// and stops early once the guess stops changing or the derivative is
+// zero (a flat tangent never crosses zero). The result is only a root
+// if the method converged, so check f of it when that matters
+func FindRoot(f, df func(float64) float64, guess float64, iters int) float64 {
+	x := guess
+	for i := 0; i < iters; i++ {
+		slope := df(x)
+		if slope == 0 {
+			break
+		}
+		next := x - f(x)/slope
+		if next == x {
+			break // Converged: another step would change nothing
+		}
+		x = next
+	}
+	return x
+}
+
+// Sqrt returns the square root of x using Newton's method on f(g) = g² - x
+// The starting guess halves the exponent of x (math.Frexp splits it into
+// mantissa and power of two), which is already within a factor of two
+// of the answer, so a handful of steps reach full precision
+// An error is returned for negative x, which has no real square root
+func Sqrt(x float64) (float64, error) {
+	if x < 0 {
+		return 0, fmt.Errorf("sqrt: negative input %v", x)
+	}
+	if x == 0 || math.IsInf(x, 1) || math.IsNaN(x) {
+		return x, nil
+	}
+
+	_, exp := math.Frexp(x)
+	guess := math.Ldexp(1, exp/2)
+	f := func(g float64) float64 { return g*g - x }
+	df := func(g float64) float64 { return 2 * g }
+	return FindRoot(f, df, guess, 100), nil
+}
@@ -0,0 +1,168 @@
+// Package functions contains tests for the numeric algorithms
+package functions
+
+import (
+	"math"
+	"testing"
+)
+
+// TestEvalPolynomial verifies results against hand-computed values
+func TestEvalPolynomial(t *testing.T) {
+	tests := []struct {
+		name     string
+		coeffs   []float64
+		x        float64
+		expected float64
+	}{
+		{"empty", nil, 5, 0},
+		{"constant", []float64{7}, 100, 7},
+		{"linear 2x+1 at 3", []float64{1, 2}, 3, 7},
+		{"x²-4 at 2", []float64{-4, 0, 1}, 2, 0},
+		{"3x³+2x²+x+5 at 2", []float64{5, 1, 2, 3}, 2, 39}, // 24 + 8 + 2 + 5
+		{"x² at -3", []float64{0, 0, 1}, -3, 9},
+		{"at zero gives constant", []float64{4, 9, 9}, 0, 4},
+		{"at half", []float64{1, 1, 1}, 0.5, 1.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvalPolynomial(tt.coeffs, tt.x); got != tt.expected {
+				t.Errorf("EvalPolynomial(%v, %v) = %v; want %v", tt.coeffs, tt.x, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestIntegrate compares against analytic integrals
+func TestIntegrate(t *testing.T) {
+	tests := []struct {
+		name      string
+		f         func(float64) float64
+		a, b      float64
+		n         int
+		expected  float64
+		tolerance float64
+	}{
+		{"constant", func(x float64) float64 { return 3 }, 0, 2, 1, 6, 1e-12},
+		{"linear is exact", func(x float64) float64 { return 2*x + 1 }, 1, 4, 3, 18, 1e-12},
+		{"quadratic", func(x float64) float64 { return x * x }, 0, 3, 1000, 9, 1e-4},
+		{"sine over half a period", math.Sin, 0, math.Pi, 1000, 2, 1e-5},
+		{"empty interval", func(x float64) float64 { return x }, 2, 2, 10, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Integrate(tt.f, tt.a, tt.b, tt.n)
+			if err != nil {
+				t.Fatalf("Integrate(%v, %v, %d) error = %v", tt.a, tt.b, tt.n, err)
+			}
+			if math.Abs(got-tt.expected) > tt.tolerance {
+				t.Errorf("Integrate(%v, %v, %d) = %v; want %v (tolerance %v)",
+					tt.a, tt.b, tt.n, got, tt.expected, tt.tolerance)
+			}
+		})
+	}
+}
+
+// TestIntegrateConverges verifies more slices reduce the error
+func TestIntegrateConverges(t *testing.T) {
+	cube := func(x float64) float64 { return x * x * x }
+	coarse, _ := Integrate(cube, 0, 2, 10)
+	fine, _ := Integrate(cube, 0, 2, 100)
+	if math.Abs(fine-4) >= math.Abs(coarse-4) {
+		t.Errorf("error with n=100 (%v) is not smaller than with n=10 (%v)", fine-4, coarse-4)
+	}
+}
+
+// TestIntegrateErrors verifies invalid arguments are rejected
+func TestIntegrateErrors(t *testing.T) {
+	identity := func(x float64) float64 { return x }
+	tests := []struct {
+		name string
+		a, b float64
+		n    int
+	}{
+		{"zero slices", 0, 1, 0},
+		{"negative slices", 0, 1, -5},
+		{"reversed bounds", 1, 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Integrate(identity, tt.a, tt.b, tt.n); err == nil {
+				t.Errorf("Integrate(%v, %v, %d) error = nil; want an error", tt.a, tt.b, tt.n)
+			}
+		})
+	}
+}
+
+// TestSqrt compares against math.Sqrt
+func TestSqrt(t *testing.T) {
+	inputs := []float64{0, 1, 2, 4, 0.25, 1e-10, 12345.678, 1e300}
+	for _, x := range inputs {
+		got, err := Sqrt(x)
+		if err != nil {
+			t.Fatalf("Sqrt(%v) error = %v", x, err)
+		}
+		want := math.Sqrt(x)
+		// Relative tolerance, since the inputs span many magnitudes
+		if math.Abs(got-want) > 1e-12*want {
+			t.Errorf("Sqrt(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
+
+// TestSqrtNegative verifies negative input is rejected
+func TestSqrtNegative(t *testing.T) {
+	if _, err := Sqrt(-4); err == nil {
+		t.Error("Sqrt(-4) error = nil; want an error")
+	}
+}
+
+// TestFindRoot verifies a known root is found
+func TestFindRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		f, df    func(float64) float64
+		guess    float64
+		expected float64
+	}{
+		{
+			// x³ - 2x - 5 = 0, Newton's own example, has a root near 2.0946
+			name:     "cubic",
+			f:        func(x float64) float64 { return x*x*x - 2*x - 5 },
+			df:       func(x float64) float64 { return 3*x*x - 2 },
+			guess:    2,
+			expected: 2.0945514815423265,
+		},
+		{
+			name:     "cosine root is pi/2",
+			f:        math.Cos,
+			df:       func(x float64) float64 { return -math.Sin(x) },
+			guess:    1,
+			expected: math.Pi / 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindRoot(tt.f, tt.df, tt.guess, 50)
+			if math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("FindRoot() = %v; want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFindRootFlatDerivative verifies a zero slope stops the search
+func TestFindRootFlatDerivative(t *testing.T) {
+	got := FindRoot(
+		func(x float64) float64 { return x*x + 1 }, // No real roots
+		func(x float64) float64 { return 2 * x },
+		0, // Slope is zero here
+		10,
+	)
+	if got != 0 {
+		t.Errorf("FindRoot() with a flat start = %v; want the guess 0 unchanged", got)
+	}
+}
@@ -0,0 +1,61 @@
+// Package functions - Retrying operations with exponential backoff
+package functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Retry calls fn up to attempts times until it succeeds
+// Between attempts it waits with exponential backoff: base, 2*base,
+// 4*base, ... This is a common pattern for flaky operations such as
+// network calls. The last error is returned if every attempt fails
+func Retry(attempts int, base time.Duration, fn func() error) error {
+	// Delegate to the context-aware version with a context that never ends
+	return RetryContext(context.Background(), attempts, base, func(context.Context) error {
+		return fn()
+	})
+}
+
+// RetryContext is like Retry but stops early when ctx is canceled
+// The context is passed to fn so it can cancel its own work too, and it
+// is checked before each attempt and while waiting between attempts
+// If ctx ends first, ctx.Err() is returned (context.Canceled or
+// context.DeadlineExceeded) so callers can tell cancellation apart
+// from fn failing
+func RetryContext(ctx context.Context, attempts int, base time.Duration, fn func(context.Context) error) error {
+	if attempts <= 0 {
+		return fmt.Errorf("retry: attempts=%d must be positive", attempts)
+	}
+
+	var lastErr error
+	backoff := NewExponentialBackoff(base, 0)
+	for attempt := 1; attempt <= attempts; attempt++ {
+		// Don't start new work if the caller has given up
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastErr = fn(ctx)
+		if lastErr == nil {
+			return nil
+		}
+
+		// No need to wait after the final attempt
+		if attempt == attempts {
+			break
+		}
+
+		// Wait for the backoff delay, or return as soon as ctx is done
+		timer := time.NewTimer(backoff.Next())
+		select {
+		case <-ctx.Done():
+			timer.Stop() // Release the timer's resources
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return fmt.Errorf("retry: all %d attempts failed: %w", attempts, lastErr)
+}
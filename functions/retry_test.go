@@ -0,0 +1,89 @@
+// Package functions contains tests for the retry helpers
+package functions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestRetrySuccess verifies Retry stops as soon as fn succeeds
+func TestRetrySuccess(t *testing.T) {
+	calls := 0
+	err := Retry(5, time.Millisecond, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Retry() error = %v; want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times; want 3", calls)
+	}
+}
+
+// TestRetryContextSuccess verifies the context-aware version succeeds
+func TestRetryContextSuccess(t *testing.T) {
+	calls := 0
+	err := RetryContext(context.Background(), 3, time.Millisecond, func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("RetryContext() = %v after %d calls; want nil after 1 call", err, calls)
+	}
+}
+
+// TestRetryContextExhausted verifies the last error is wrapped when every
+// attempt fails
+func TestRetryContextExhausted(t *testing.T) {
+	errFail := errors.New("always fails")
+	calls := 0
+	err := RetryContext(context.Background(), 4, time.Millisecond, func(ctx context.Context) error {
+		calls++
+		return errFail
+	})
+
+	if !errors.Is(err, errFail) {
+		t.Errorf("RetryContext() error = %v; want to wrap %v", err, errFail)
+	}
+	if calls != 4 {
+		t.Errorf("fn called %d times; want 4", calls)
+	}
+}
+
+// TestRetryContextCanceled verifies a short deadline aborts the retries
+func TestRetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	start := time.Now()
+	// A long base delay means only the deadline can end this quickly
+	err := RetryContext(ctx, 10, time.Second, func(ctx context.Context) error {
+		calls++
+		return errors.New("still failing")
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RetryContext() error = %v; want %v", err, context.DeadlineExceeded)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times; want 1", calls)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("RetryContext() took %v; want early return on cancellation", elapsed)
+	}
+}
+
+// TestRetryInvalidAttempts verifies a non-positive attempt count errors
+func TestRetryInvalidAttempts(t *testing.T) {
+	if err := Retry(0, time.Millisecond, func() error { return nil }); err == nil {
+		t.Error("Retry() with 0 attempts should return an error")
+	}
+}
@@ -0,0 +1,41 @@
+// Package functions - Turning panics into errors
+package functions
+
+import "fmt"
+
+// SafeCall runs fn and converts a panic into an error instead of letting
+// it crash the program
+// The deferred function calls recover, which stops the panic and returns
+// the value passed to panic; assigning to the named result err is how a
+// deferred function changes what SafeCall returns. If the panic value
+// is itself an error it is wrapped, so errors.Is and errors.As still
+// see it. SafeCall returns nil when fn returns normally
+func SafeCall(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+// SafeCallR is SafeCall for functions that return a value
+// On a panic the zero value of T is returned along with the error
+func SafeCallR[T any](fn func() T) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero T
+			result, err = zero, panicError(r)
+		}
+	}()
+	return fn(), nil
+}
+
+// panicError describes a recovered panic value as an error
+func panicError(r any) error {
+	if e, ok := r.(error); ok {
+		return fmt.Errorf("recovered panic: %w", e)
+	}
+	return fmt.Errorf("recovered panic: %v", r)
+}
@@ -0,0 +1,70 @@
+// Package functions - Tests for the panic-recovering wrappers
+package functions
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestSafeCall verifies normal returns and panics with various values
+func TestSafeCall(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func()
+		wantErr string // Substring of the error, or "" for no error
+	}{
+		{"no panic", func() {}, ""},
+		{"string panic", func() { panic("something broke") }, "something broke"},
+		{"index out of range", func() {
+			var s []int
+			_ = s[3]
+		}, "index out of range"},
+		{"nil map write", func() {
+			var m map[string]int
+			m["a"] = 1
+		}, "nil map"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SafeCall(tt.fn)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("SafeCall() = %v; want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("SafeCall() = %v; want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestSafeCallWrapsError verifies an error passed to panic can still be
+// matched with errors.Is
+func TestSafeCallWrapsError(t *testing.T) {
+	errBoom := errors.New("boom")
+	err := SafeCall(func() { panic(errBoom) })
+	if !errors.Is(err, errBoom) {
+		t.Errorf("SafeCall() = %v; want it to wrap %v", err, errBoom)
+	}
+}
+
+// TestSafeCallR verifies the value is returned normally and the zero
+// value plus an error is returned on panic
+func TestSafeCallR(t *testing.T) {
+	got, err := SafeCallR(func() int { return 42 })
+	if got != 42 || err != nil {
+		t.Errorf("SafeCallR(42) = %d, %v; want 42, nil", got, err)
+	}
+
+	got, err = SafeCallR(func() int {
+		divisor := 0
+		return 1 / divisor
+	})
+	if got != 0 || err == nil || !strings.Contains(err.Error(), "divide by zero") {
+		t.Errorf("SafeCallR(1/0) = %d, %v; want 0, divide by zero error", got, err)
+	}
+}
@@ -3,5 +3,5 @@
 module github.com/hungvhau/mastering-golang
 
 // Specify the Go version we're using
-// Go 1.21 is a stable version with good features
-go 1.21 
\ No newline at end of file
+// Go 1.23 is the first version with range-over-func iterators (package iter)
+go 1.23 
\ No newline at end of file
@@ -0,0 +1,75 @@
+// Package jsonutil - Flattening nested maps into a single level
+package jsonutil
+
+import (
+	"sort"
+	"strings"
+)
+
+// FlattenMap turns nested map[string]any values into a single-level map
+// whose keys are the paths joined by sep
+// {"a": {"b": 1}, "c": 2} with sep "." becomes {"a.b": 1, "c": 2}. This
+// is handy for config files, where flat keys are easy to override from
+// environment variables. Non-map values (including slices) are kept as
+// leaves, and an empty nested map is kept as-is so UnflattenMap can
+// restore it. An empty sep defaults to "."
+func FlattenMap(m map[string]any, sep string) map[string]any {
+	if sep == "" {
+		sep = "."
+	}
+	result := make(map[string]any)
+	flattenInto(result, "", m, sep)
+	return result
+}
+
+// flattenInto copies m into result, prefixing each key with prefix
+func flattenInto(result map[string]any, prefix string, m map[string]any, sep string) {
+	for key, value := range m {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + sep + key
+		}
+
+		// Recurse into non-empty maps; everything else is a leaf
+		if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+			flattenInto(result, fullKey, nested, sep)
+			continue
+		}
+		result[fullKey] = value
+	}
+}
+
+// UnflattenMap is the inverse of FlattenMap: it splits each key on sep
+// and rebuilds the nested maps
+// If a key is both a leaf and a prefix of another key (say "a" and
+// "a.b"), the nested path wins, since keys are applied in sorted order
+// and "a" sorts first. An empty sep defaults to "."
+func UnflattenMap(m map[string]any, sep string) map[string]any {
+	if sep == "" {
+		sep = "."
+	}
+
+	// Sort the keys so conflicts resolve the same way every time
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]any)
+	for _, key := range keys {
+		parts := strings.Split(key, sep)
+		current := result
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := current[part].(map[string]any)
+			if !ok {
+				// Missing, or a leaf in the way: start a new level
+				next = make(map[string]any)
+				current[part] = next
+			}
+			current = next
+		}
+		current[parts[len(parts)-1]] = m[key]
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+// Package jsonutil contains tests for flattening nested maps
+package jsonutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestFlattenMap verifies nested keys are joined with the separator
+func TestFlattenMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]any
+		sep      string
+		expected map[string]any
+	}{
+		{
+			name:     "deeply nested",
+			input:    map[string]any{"a": map[string]any{"b": map[string]any{"c": 1}}},
+			sep:      ".",
+			expected: map[string]any{"a.b.c": 1},
+		},
+		{
+			name: "mixed nested and leaf values",
+			input: map[string]any{
+				"name": "app",
+				"db":   map[string]any{"host": "localhost", "port": 5432},
+				"tags": []any{"x", "y"},
+			},
+			sep: ".",
+			expected: map[string]any{
+				"name":    "app",
+				"db.host": "localhost",
+				"db.port": 5432,
+				"tags":    []any{"x", "y"},
+			},
+		},
+		{
+			name:     "custom separator",
+			input:    map[string]any{"server": map[string]any{"port": 80}},
+			sep:      "_",
+			expected: map[string]any{"server_port": 80},
+		},
+		{
+			name:     "empty separator defaults to dot",
+			input:    map[string]any{"a": map[string]any{"b": true}},
+			sep:      "",
+			expected: map[string]any{"a.b": true},
+		},
+		{
+			name:     "empty nested map kept",
+			input:    map[string]any{"a": map[string]any{}},
+			sep:      ".",
+			expected: map[string]any{"a": map[string]any{}},
+		},
+		{
+			name:     "empty input",
+			input:    map[string]any{},
+			sep:      ".",
+			expected: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FlattenMap(tt.input, tt.sep)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("FlattenMap(%v, %q) = %v; want %v", tt.input, tt.sep, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestUnflattenMap verifies keys are split back into nested maps
+func TestUnflattenMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]any
+		expected map[string]any
+	}{
+		{
+			name:  "shared prefixes",
+			input: map[string]any{"db.host": "h", "db.port": 1, "debug": false},
+			expected: map[string]any{
+				"db":    map[string]any{"host": "h", "port": 1},
+				"debug": false,
+			},
+		},
+		{
+			name:     "nested path wins over leaf",
+			input:    map[string]any{"a": 1, "a.b": 2},
+			expected: map[string]any{"a": map[string]any{"b": 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UnflattenMap(tt.input, ".")
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("UnflattenMap(%v) = %v; want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFlattenRoundTrip verifies UnflattenMap undoes FlattenMap
+func TestFlattenRoundTrip(t *testing.T) {
+	inputs := []map[string]any{
+		{},
+		{"a": 1},
+		{"a": map[string]any{"b": map[string]any{"c": "deep"}, "d": []any{1, 2}}, "e": nil},
+		{"config": map[string]any{"empty": map[string]any{}, "on": true}},
+	}
+
+	for _, input := range inputs {
+		for _, sep := range []string{".", "/", "__"} {
+			got := UnflattenMap(FlattenMap(input, sep), sep)
+			if !reflect.DeepEqual(got, input) {
+				t.Errorf("UnflattenMap(FlattenMap(%v, %q)) = %v; want the original", input, sep, got)
+			}
+		}
+	}
+}
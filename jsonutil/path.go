@@ -0,0 +1,32 @@
+// Package jsonutil demonstrates working with decoded JSON documents.
+// When JSON is unmarshaled into `any`, objects become map[string]any and
+// arrays become []any. Walking those values needs a type assertion at
+// every level, so this package wraps the common patterns in helpers.
+package jsonutil
+
+import "strings"
+
+// GetPath looks up a dot-separated path such as "user.address.city" in
+// nested map[string]any values, as produced by json.Unmarshal
+// It returns false if any segment is missing, or if an intermediate
+// value is not a map (for example a string or a []any). An empty path
+// returns m itself
+func GetPath(m map[string]any, path string) (any, bool) {
+	if path == "" {
+		return m, true
+	}
+
+	var current any = m
+	for _, key := range strings.Split(path, ".") {
+		// Each step needs a map to index into
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = obj[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,59 @@
+// Package jsonutil contains tests for the nested path lookup
+package jsonutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestGetPath verifies lookups through nested maps
+func TestGetPath(t *testing.T) {
+	var doc map[string]any
+	err := json.Unmarshal([]byte(`{
+		"user": {
+			"name": "Ada",
+			"address": {"city": "London", "zip": null},
+			"tags": ["admin", "dev"]
+		},
+		"count": 3
+	}`), &doc)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected any
+		found    bool
+	}{
+		{"deep path", "user.address.city", "London", true},
+		{"top-level number", "count", 3.0, true},
+		{"explicit null", "user.address.zip", nil, true},
+		{"array value", "user.tags", []any{"admin", "dev"}, true},
+		{"missing leaf", "user.address.country", nil, false},
+		{"missing intermediate key", "user.profile.city", nil, false},
+		{"through a string", "user.name.first", nil, false},
+		{"through an array", "user.tags.0", nil, false},
+		{"through a number", "count.value", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GetPath(doc, tt.path)
+			if ok != tt.found || !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("GetPath(%q) = %v, %v; want %v, %v", tt.path, got, ok, tt.expected, tt.found)
+			}
+		})
+	}
+}
+
+// TestGetPathEmpty verifies an empty path returns the whole map
+func TestGetPathEmpty(t *testing.T) {
+	m := map[string]any{"a": 1}
+	got, ok := GetPath(m, "")
+	if !ok || !reflect.DeepEqual(got, m) {
+		t.Errorf("GetPath(%q) = %v, %v; want %v, true", "", got, ok, m)
+	}
+}
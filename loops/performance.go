@@ -0,0 +1,26 @@
+// Package loops - Benchmarkable slice-building strategies
+package loops
+
+// BuildPreallocated returns [0, 10, 20, ...] with n elements, allocating
+// the whole slice up front with make so the loop only assigns
+// This is the efficient version from LoopPerformanceConsiderations,
+// pulled out so it can be benchmarked with `go test -bench=.`
+func BuildPreallocated(n int) []int {
+	result := make([]int, n) // One allocation of exactly the right size
+	for i := 0; i < n; i++ {
+		result[i] = i * 10
+	}
+	return result
+}
+
+// BuildDynamic returns the same values as BuildPreallocated but grows the
+// slice with append, starting from empty
+// Whenever the capacity runs out, append allocates a bigger array and
+// copies everything over, so large n means many allocations and copies
+func BuildDynamic(n int) []int {
+	result := []int{}
+	for i := 0; i < n; i++ {
+		result = append(result, i*10) // May reallocate and copy
+	}
+	return result
+}
@@ -50,6 +50,28 @@ func main() {
 	fmt.Println("│   └── loops.go           # All loop concepts")
 	fmt.Println("├── collections/            # Collections package")
 	fmt.Println("│   └── collections.go     # Arrays, slices, and maps")
+	fmt.Println("├── concurrency/            # Concurrency package")
+	fmt.Println("│   ├── workers.go         # Worker pools")
+	fmt.Println("│   ├── pipeline.go        # Channel pipelines")
+	fmt.Println("│   ├── tee.go             # Channel broadcasting")
+	fmt.Println("│   ├── ratelimiter.go     # Rate limiting")
+	fmt.Println("│   ├── semaphore.go       # Semaphores")
+	fmt.Println("│   ├── counter.go         # Atomic counter")
+	fmt.Println("│   ├── concurrentmap.go   # Concurrent map")
+	fmt.Println("│   ├── philosophers.go    # Dining philosophers")
+	fmt.Println("│   ├── eventbus.go        # Pub/sub event bus")
+	fmt.Println("│   ├── slidingwindow.go   # Sliding-window rate limiting")
+	fmt.Println("│   ├── scheduler.go       # Periodic jobs with cancellation")
+	fmt.Println("│   ├── group.go           # Goroutine groups with first-error reporting")
+	fmt.Println("│   └── producerconsumer.go # Producer-consumer fan-out")
+	fmt.Println("├── textutil/               # Text utilities package")
+	fmt.Println("├── encoding/               # Encoding package")
+	fmt.Println("├── config/                 # Configuration package")
+	fmt.Println("├── compare/                # Comparison package")
+	fmt.Println("├── jsonutil/               # JSON utilities package")
+	fmt.Println("├── money/                  # Currency package")
+	fmt.Println("├── optional/               # Optional values package")
+	fmt.Println("├── result/                 # Result type package")
 	fmt.Println("└── cmd/                    # Executable programs")
 	fmt.Println("    ├── 01-basics/")
 	fmt.Println("    │   └── main.go        # Demo runner for basics")
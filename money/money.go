@@ -0,0 +1,102 @@
+// Package money demonstrates fixed-point arithmetic for currency.
+// Most decimal fractions, like 0.10, have no exact float64
+// representation, so adding prices as floats slowly drifts away from
+// the right answer. Storing a whole number of cents in an int64 keeps
+// every addition and subtraction exact.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrOverflow is wrapped by the checked operations when a result is
+// beyond what an int64 number of cents can hold
+var ErrOverflow = errors.New("amount overflows int64 cents")
+
+// Money is an amount of currency stored as a whole number of cents
+// Because it is an integer, Add and Sub never lose precision. The zero
+// value is $0.00
+type Money int64
+
+// FromFloat converts a dollar amount such as 12.34 to Money, rounding
+// to the nearest cent (halves round away from zero)
+// Rounding matters: 0.29 * 100 is 28.999999999999996 in float64, which
+// would truncate to 28 cents
+func FromFloat(dollars float64) Money {
+	return Money(math.Round(dollars * 100))
+}
+
+// ToFloat converts m back to dollars, e.g. for charts or statistics
+// Convert only at the edges of a program; do arithmetic on Money
+func (m Money) ToFloat() float64 {
+	return float64(m) / 100
+}
+
+// Add returns m + other
+// Like any int64 addition it wraps around silently past about 92
+// quadrillion dollars; use AddChecked when that could happen
+func (m Money) Add(other Money) Money {
+	return m + other
+}
+
+// Sub returns m - other, which may be negative
+func (m Money) Sub(other Money) Money {
+	return m - other
+}
+
+// Mul returns m multiplied by a whole quantity, such as a price times
+// the number of items bought
+func (m Money) Mul(quantity int) Money {
+	return m * Money(quantity)
+}
+
+// AddChecked is Add, but returns an error wrapping ErrOverflow instead
+// of a wrapped-around sum
+// The check runs before adding, since an overflowed sum is already wrong
+func (m Money) AddChecked(other Money) (Money, error) {
+	if (other > 0 && m > math.MaxInt64-other) || (other < 0 && m < math.MinInt64-other) {
+		return 0, fmt.Errorf("money: add %v %v: %w", m, other, ErrOverflow)
+	}
+	return m + other, nil
+}
+
+// SubChecked is Sub, but returns an error wrapping ErrOverflow instead
+// of a wrapped-around difference
+func (m Money) SubChecked(other Money) (Money, error) {
+	if (other < 0 && m > math.MaxInt64+other) || (other > 0 && m < math.MinInt64+other) {
+		return 0, fmt.Errorf("money: sub %v %v: %w", m, other, ErrOverflow)
+	}
+	return m - other, nil
+}
+
+// MulChecked is Mul, but returns an error wrapping ErrOverflow instead
+// of a wrapped-around product
+func (m Money) MulChecked(quantity int) (Money, error) {
+	q := Money(quantity)
+	if m == 0 || q == 0 {
+		return 0, nil
+	}
+	product := m * q
+	// Dividing back recovers m only if nothing was lost. MinInt64 * -1 is
+	// the one case that fools the check, because MinInt64 / -1 also wraps
+	if product/q != m || (m == -1 && q == math.MinInt64) || (q == -1 && m == math.MinInt64) {
+		return 0, fmt.Errorf("money: mul %v %d: %w", m, quantity, ErrOverflow)
+	}
+	return product, nil
+}
+
+// String formats m as dollars and cents: "$12.34", "-$0.05"
+// It implements fmt.Stringer, so %v and Println use it automatically
+func (m Money) String() string {
+	sign := ""
+	// Work with the magnitude as a uint64: negating math.MinInt64 as an
+	// int64 overflows back to itself, but its magnitude fits in a uint64
+	cents := uint64(m)
+	if m < 0 {
+		sign = "-"
+		cents = uint64(-(m + 1)) + 1
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
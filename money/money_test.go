@@ -0,0 +1,136 @@
+// Package money contains tests for the Money type
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestFromFloat verifies dollars are rounded to the nearest cent
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		input    float64
+		expected Money
+	}{
+		{12.34, 1234},
+		{0.29, 29},   // 0.29 * 100 is just below 29 in float64
+		{1.005, 100}, // 1.005 is stored as 1.00499..., so it rounds down
+		{0.015, 2},
+		{-3.5, -350},
+		{0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.input), func(t *testing.T) {
+			if got := FromFloat(tt.input); got != tt.expected {
+				t.Errorf("FromFloat(%v) = %d; want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestArithmetic verifies Add, Sub, Mul and ToFloat
+func TestArithmetic(t *testing.T) {
+	price := FromFloat(19.99)
+	if got := price.Mul(3); got != 5997 {
+		t.Errorf("Mul(3) = %d; want 5997", got)
+	}
+	if got := price.Add(FromFloat(0.01)); got != 2000 {
+		t.Errorf("Add(0.01) = %d; want 2000", got)
+	}
+	if got := price.Sub(FromFloat(20)); got != -1 {
+		t.Errorf("Sub(20) = %d; want -1", got)
+	}
+	if got := price.ToFloat(); got != 19.99 {
+		t.Errorf("ToFloat() = %v; want 19.99", got)
+	}
+}
+
+// TestCheckedArithmetic verifies the checked variants report results
+// that don't fit in an int64 instead of wrapping around
+func TestCheckedArithmetic(t *testing.T) {
+	const maxMoney, minMoney = Money(math.MaxInt64), Money(math.MinInt64)
+
+	tests := []struct {
+		name      string
+		op        func() (Money, error)
+		expected  Money
+		wantError bool
+	}{
+		{"add overflow", func() (Money, error) { return maxMoney.AddChecked(1) }, 0, true},
+		{"add to max", func() (Money, error) { return (maxMoney - 1).AddChecked(1) }, maxMoney, false},
+		{"sub overflow", func() (Money, error) { return minMoney.SubChecked(1) }, 0, true},
+		{"sub min from zero", func() (Money, error) { return Money(0).SubChecked(minMoney) }, 0, true},
+		{"sub min from negative", func() (Money, error) { return Money(-1).SubChecked(minMoney) }, maxMoney, false},
+		{"mul overflow", func() (Money, error) { return maxMoney.MulChecked(2) }, 0, true},
+		{"mul min by -1", func() (Money, error) { return minMoney.MulChecked(-1) }, 0, true},
+		{"mul negative", func() (Money, error) { return Money(-250).MulChecked(4) }, -1000, false},
+		{"add negative", func() (Money, error) { return Money(-5).AddChecked(-5) }, -10, false},
+		{"sub to min", func() (Money, error) { return (minMoney + 1).SubChecked(1) }, minMoney, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op()
+			if tt.wantError {
+				if !errors.Is(err, ErrOverflow) {
+					t.Errorf("got %d, %v; want error wrapping %v", got, err, ErrOverflow)
+				}
+				return
+			}
+			if got != tt.expected || err != nil {
+				t.Errorf("got %d, %v; want %d, nil", got, err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestString verifies dollar formatting
+func TestString(t *testing.T) {
+	tests := []struct {
+		input    Money
+		expected string
+	}{
+		{1234, "$12.34"},
+		{5, "$0.05"},
+		{100, "$1.00"},
+		{0, "$0.00"},
+		{-5, "-$0.05"},
+		{-123456, "-$1234.56"},
+		{math.MaxInt64, "$92233720368547758.07"},
+		{math.MinInt64, "-$92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := tt.input.String(); got != tt.expected {
+				t.Errorf("Money(%d).String() = %q; want %q", int64(tt.input), got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSumIsExact shows why cents beat floats: adding ten cents a
+// thousand times gives exactly $100 with Money but not with float64
+func TestSumIsExact(t *testing.T) {
+	var floatTotal float64
+	var moneyTotal Money
+	for i := 0; i < 1000; i++ {
+		floatTotal += 0.10
+		moneyTotal = moneyTotal.Add(FromFloat(0.10))
+	}
+
+	if moneyTotal != FromFloat(100) {
+		t.Errorf("Money total = %v; want $100.00", moneyTotal)
+	}
+	if moneyTotal.String() != "$100.00" {
+		t.Errorf("Money total String() = %q; want \"$100.00\"", moneyTotal.String())
+	}
+
+	// Documents the float drift this package avoids
+	if floatTotal == 100 {
+		t.Errorf("float64 total = %v; expected it to drift from 100", floatTotal)
+	}
+}
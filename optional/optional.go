@@ -0,0 +1,55 @@
+// Package optional demonstrates an Optional type for values that may be
+// missing. Go usually signals "no value" with a (value, ok) pair or a
+// nil pointer; Optional bundles the value and its presence together so
+// the two can't drift apart, and makes the fallback explicit at the
+// call site.
+package optional
+
+// Optional holds either a value of type T or nothing
+// The zero value is empty, the same as None
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some returns an Optional holding v
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, present: true}
+}
+
+// None returns an empty Optional
+// The type parameter must be given explicitly, e.g. None[int](), since
+// there is no argument to infer it from
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// IsPresent reports whether o holds a value
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get returns the value and true, or the zero value of T and false if
+// o is empty - the same comma-ok shape as a map lookup
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// OrElse returns the value if present, otherwise def
+func (o Optional[T]) OrElse(def T) T {
+	if !o.present {
+		return def
+	}
+	return o.value
+}
+
+// Map applies fn to the value in o and wraps the result, or returns an
+// empty Optional without calling fn if o is empty
+// It is a function rather than a method because Go methods cannot
+// introduce new type parameters such as U
+func Map[T, U any](o Optional[T], fn func(T) U) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
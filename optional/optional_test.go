@@ -0,0 +1,65 @@
+// Package optional - Tests for Optional
+package optional
+
+import (
+	"strconv"
+	"testing"
+)
+
+// TestSome verifies a present value is reported and returned
+func TestSome(t *testing.T) {
+	o := Some(42)
+	if !o.IsPresent() {
+		t.Error("Some(42).IsPresent() = false; want true")
+	}
+	if v, ok := o.Get(); v != 42 || !ok {
+		t.Errorf("Some(42).Get() = %d, %t; want 42, true", v, ok)
+	}
+	if v := o.OrElse(7); v != 42 {
+		t.Errorf("Some(42).OrElse(7) = %d; want 42", v)
+	}
+}
+
+// TestNone verifies an empty Optional and the zero value behave alike
+func TestNone(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Optional[string]
+	}{
+		{"None", None[string]()},
+		{"zero value", Optional[string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.o.IsPresent() {
+				t.Error("IsPresent() = true; want false")
+			}
+			if v, ok := tt.o.Get(); v != "" || ok {
+				t.Errorf("Get() = %q, %t; want \"\", false", v, ok)
+			}
+			if v := tt.o.OrElse("default"); v != "default" {
+				t.Errorf("OrElse(%q) = %q; want %q", "default", v, "default")
+			}
+		})
+	}
+}
+
+// TestMap verifies Map transforms present values and skips empty ones
+func TestMap(t *testing.T) {
+	if v, ok := Map(Some(7), strconv.Itoa).Get(); v != "7" || !ok {
+		t.Errorf("Map(Some(7), Itoa).Get() = %q, %t; want \"7\", true", v, ok)
+	}
+
+	called := false
+	got := Map(None[int](), func(n int) string {
+		called = true
+		return strconv.Itoa(n)
+	})
+	if got.IsPresent() {
+		t.Error("Map(None, fn).IsPresent() = true; want false")
+	}
+	if called {
+		t.Error("Map(None, fn) called fn; want it skipped")
+	}
+}
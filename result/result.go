@@ -0,0 +1,46 @@
+// Package result demonstrates a Result type that carries either a value
+// or an error. Go's idiom is to return (value, error) pairs; Result
+// packs that pair into one value, which is handy when results are
+// stored in slices or sent over channels, and Unwrap turns it straight
+// back into the familiar pair.
+package result
+
+// Result holds either a successful value of type T or an error
+// The zero value is a success holding the zero value of T
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err
+// The type parameter must be given explicitly, e.g. Err[int](err)
+// Passing a nil error yields a success, because success is defined by
+// the absence of an error
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOk reports whether r holds a value rather than an error
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Unwrap returns the value and error in the usual Go order, so the
+// caller can handle it with a plain "if err != nil" check
+// On failure the value is the zero value of T
+func (r Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// UnwrapOr returns the value on success, or def on failure
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
@@ -0,0 +1,45 @@
+// Package result - Tests for Result
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestOk verifies a successful Result returns its value
+func TestOk(t *testing.T) {
+	r := Ok("done")
+	if !r.IsOk() {
+		t.Error("Ok(\"done\").IsOk() = false; want true")
+	}
+	if v, err := r.Unwrap(); v != "done" || err != nil {
+		t.Errorf("Ok(\"done\").Unwrap() = %q, %v; want \"done\", nil", v, err)
+	}
+	if v := r.UnwrapOr("fallback"); v != "done" {
+		t.Errorf("Ok(\"done\").UnwrapOr(\"fallback\") = %q; want \"done\"", v)
+	}
+}
+
+// TestErr verifies a failed Result returns its error and the default
+func TestErr(t *testing.T) {
+	errNotFound := errors.New("not found")
+	r := Err[int](errNotFound)
+
+	if r.IsOk() {
+		t.Error("Err(errNotFound).IsOk() = true; want false")
+	}
+	v, err := r.Unwrap()
+	if v != 0 || !errors.Is(err, errNotFound) {
+		t.Errorf("Err(errNotFound).Unwrap() = %d, %v; want 0, %v", v, err, errNotFound)
+	}
+	if v := r.UnwrapOr(-1); v != -1 {
+		t.Errorf("Err(errNotFound).UnwrapOr(-1) = %d; want -1", v)
+	}
+}
+
+// TestErrNil verifies a nil error counts as success
+func TestErrNil(t *testing.T) {
+	if r := Err[int](nil); !r.IsOk() {
+		t.Error("Err(nil).IsOk() = false; want true")
+	}
+}
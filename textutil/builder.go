@@ -0,0 +1,31 @@
+// Package textutil - Building strings efficiently
+package textutil
+
+import "strings"
+
+// BuildStringNaive builds an n-letter string "abc...zabc..." using +=
+// Go strings are immutable, so every += allocates a new string and
+// copies everything built so far. The total work grows with n², which
+// is why WhileStyleLoop's `word += "a"` pattern doesn't scale
+func BuildStringNaive(n int) string {
+	s := ""
+	for i := 0; i < n; i++ {
+		s += string(rune('a' + i%26)) // Copies all of s every time
+	}
+	return s
+}
+
+// BuildStringBuilder builds the same string as BuildStringNaive with a
+// strings.Builder
+// The builder appends into a growable byte buffer, and Grow reserves the
+// final size up front, so the whole string is built with one allocation
+func BuildStringBuilder(n int) string {
+	var b strings.Builder
+	if n > 0 {
+		b.Grow(n) // One byte per letter
+	}
+	for i := 0; i < n; i++ {
+		b.WriteByte(byte('a' + i%26))
+	}
+	return b.String()
+}
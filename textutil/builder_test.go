@@ -0,0 +1,55 @@
+// Package textutil contains tests and benchmarks for string building
+package textutil
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestBuildStringMatch verifies both builders produce the same string, so
+// the benchmarks below compare equivalent work
+func TestBuildStringMatch(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected string
+	}{
+		{0, ""},
+		{1, "a"},
+		{5, "abcde"},
+		{28, "abcdefghijklmnopqrstuvwxyzab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("n=%d", tt.n), func(t *testing.T) {
+			if got := BuildStringNaive(tt.n); got != tt.expected {
+				t.Errorf("BuildStringNaive(%d) = %q; want %q", tt.n, got, tt.expected)
+			}
+			if got := BuildStringBuilder(tt.n); got != tt.expected {
+				t.Errorf("BuildStringBuilder(%d) = %q; want %q", tt.n, got, tt.expected)
+			}
+		})
+	}
+
+	if BuildStringNaive(5000) != BuildStringBuilder(5000) {
+		t.Error("BuildStringNaive(5000) and BuildStringBuilder(5000) differ")
+	}
+}
+
+// buildSize makes the quadratic cost of += clearly visible
+const buildSize = 5000
+
+// BenchmarkBuildStringNaive measures building a string with +=
+// Run with: go test -bench=BuildString -benchmem ./textutil
+func BenchmarkBuildStringNaive(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		BuildStringNaive(buildSize)
+	}
+}
+
+// BenchmarkBuildStringBuilder measures building the same string with
+// strings.Builder, which grows one buffer instead of copying every time
+func BenchmarkBuildStringBuilder(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		BuildStringBuilder(buildSize)
+	}
+}
@@ -0,0 +1,36 @@
+// Package textutil - The Caesar cipher, a classic shift cipher
+package textutil
+
+import "strings"
+
+// CaesarEncrypt shifts every ASCII letter in s forward by shift places,
+// wrapping around the alphabet ('z' shifted by 1 is 'a') and keeping its
+// case. Everything else - digits, spaces, punctuation, non-ASCII
+// characters - is left unchanged. Negative shifts move backwards, and
+// any shift is reduced modulo 26, so a shift of 27 is the same as 1
+func CaesarEncrypt(s string, shift int) string {
+	// Normalize into [0, 26). Go's % keeps the sign of the dividend,
+	// so -1 % 26 is -1; adding 26 and taking % again fixes that
+	shift = (shift%26 + 26) % 26
+
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+			builder.WriteRune('a' + (r-'a'+rune(shift))%26)
+		case r >= 'A' && r <= 'Z':
+			builder.WriteRune('A' + (r-'A'+rune(shift))%26)
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// CaesarDecrypt reverses CaesarEncrypt by shifting in the opposite
+// direction, so CaesarDecrypt(CaesarEncrypt(s, k), k) == s for any k
+func CaesarDecrypt(s string, shift int) string {
+	// Reduce first so negating never overflows for extreme values
+	return CaesarEncrypt(s, -(shift % 26))
+}
@@ -0,0 +1,49 @@
+// Package textutil contains tests for the Caesar cipher
+package textutil
+
+import (
+	"math"
+	"testing"
+)
+
+// TestCaesarEncrypt verifies known encodings, case and wrapping
+func TestCaesarEncrypt(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		shift    int
+		expected string
+	}{
+		{"classic shift 3", "Hello, World!", 3, "Khoor, Zruog!"},
+		{"wraps around", "xyz XYZ", 3, "abc ABC"},
+		{"negative shift", "abc", -1, "zab"},
+		{"large shift", "abc", 26*4 + 1, "bcd"},
+		{"large negative shift", "abc", -27, "zab"},
+		{"zero shift", "Go 1.21", 0, "Go 1.21"},
+		{"non-ascii untouched", "café 世界", 1, "dbgé 世界"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CaesarEncrypt(tt.input, tt.shift); got != tt.expected {
+				t.Errorf("CaesarEncrypt(%q, %d) = %q; want %q", tt.input, tt.shift, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCaesarRoundTrip verifies decrypting undoes encrypting for any shift
+func TestCaesarRoundTrip(t *testing.T) {
+	message := "The Quick Brown Fox Jumps Over The Lazy Dog - 123!"
+	for _, shift := range []int{0, 1, 13, 25, 26, 52, -3, -100, 1000, math.MaxInt, math.MinInt} {
+		encrypted := CaesarEncrypt(message, shift)
+		if got := CaesarDecrypt(encrypted, shift); got != message {
+			t.Errorf("round trip with shift %d = %q; want %q", shift, got, message)
+		}
+	}
+
+	// ROT13 is its own inverse
+	if got := CaesarEncrypt(CaesarEncrypt("Hello", 13), 13); got != "Hello" {
+		t.Errorf("ROT13 twice = %q; want %q", got, "Hello")
+	}
+}
@@ -0,0 +1,66 @@
+// Package textutil - Parsing a single CSV line
+package textutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCSVLine splits one line of comma-separated values into fields
+// following the usual CSV rules (RFC 4180):
+//   - a field wrapped in double quotes may contain commas: "a,b"
+//   - inside a quoted field, a doubled quote is a literal quote: "say ""hi"""
+//   - quotes in the middle of an unquoted field are kept as-is
+//
+// An error is returned for an unterminated quoted field, or for text
+// between a closing quote and the next comma. For whole files, use the
+// standard library's encoding/csv package instead
+func ParseCSVLine(line string) ([]string, error) {
+	fields := []string{}
+	var field strings.Builder
+	runes := []rune(line)
+
+	i := 0
+	for {
+		field.Reset()
+
+		if i < len(runes) && runes[i] == '"' {
+			// Quoted field: read until a quote that isn't doubled
+			start := i
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("parse csv: unterminated quoted field starting at position %d", start)
+				}
+				if runes[i] == '"' {
+					if i+1 < len(runes) && runes[i+1] == '"' {
+						field.WriteRune('"') // Escaped quote
+						i += 2
+						continue
+					}
+					i++ // Closing quote
+					break
+				}
+				field.WriteRune(runes[i])
+				i++
+			}
+			// Only a comma or the end of the line may follow
+			if i < len(runes) && runes[i] != ',' {
+				return nil, fmt.Errorf("parse csv: unexpected %q after closing quote at position %d", runes[i], i)
+			}
+		} else {
+			// Unquoted field: read up to the next comma
+			for i < len(runes) && runes[i] != ',' {
+				field.WriteRune(runes[i])
+				i++
+			}
+		}
+
+		fields = append(fields, field.String())
+
+		if i >= len(runes) {
+			return fields, nil
+		}
+		i++ // Skip the comma; a trailing comma yields a final empty field
+	}
+}
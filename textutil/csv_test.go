@@ -0,0 +1,58 @@
+// Package textutil contains tests for the CSV line parser
+package textutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseCSVLine verifies plain, quoted and escaped fields
+func TestParseCSVLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"plain fields", "apple,10,0.50", []string{"apple", "10", "0.50"}},
+		{"quoted with comma", `"Smith, John",42`, []string{"Smith, John", "42"}},
+		{"escaped quotes", `"say ""hi""",x`, []string{`say "hi"`, "x"}},
+		{"empty fields", "a,,c,", []string{"a", "", "c", ""}},
+		{"empty quoted field", `"",b`, []string{"", "b"}},
+		{"quote inside unquoted", `5" screen,ok`, []string{`5" screen`, "ok"}},
+		{"spaces preserved", " a , b ", []string{" a ", " b "}},
+		{"unicode", `"café, crème",世界`, []string{"café, crème", "世界"}},
+		{"empty line", "", []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCSVLine(tt.input)
+			if err != nil {
+				t.Fatalf("ParseCSVLine(%q) returned error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseCSVLine(%q) = %q; want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestParseCSVLineMalformed verifies malformed lines return errors
+func TestParseCSVLineMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unterminated quote", `a,"unterminated`},
+		{"unterminated after escape", `"ends with escaped quote""`},
+		{"text after closing quote", `"quoted"extra,b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ParseCSVLine(tt.input); err == nil {
+				t.Errorf("ParseCSVLine(%q) = %q; want an error", tt.input, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Package textutil - Human-friendly durations
+package textutil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HumanizeDuration formats d for people rather than machines: "2h 3m",
+// "45s", "3d 4h". Only non-zero units are shown, largest first, and a
+// day is counted as 24 hours
+// Durations of a second or more drop their fractional seconds.
+// Shorter durations use a single unit instead: "250ms", "40µs", "15ns".
+// Zero is "0s", and negative durations get a leading minus sign
+// Compare time.Duration's String method, which prints "74h3m0s"
+func HumanizeDuration(d time.Duration) string {
+	// Work with the magnitude as a uint64: negating the minimum Duration
+	// (about -292 years) as an int64 overflows back to itself, but its
+	// magnitude fits in a uint64
+	sign := ""
+	ns := uint64(d)
+	if d < 0 {
+		sign = "-"
+		ns = uint64(-(d + 1)) + 1
+	}
+
+	const (
+		microsecond = uint64(time.Microsecond)
+		millisecond = uint64(time.Millisecond)
+		second      = uint64(time.Second)
+	)
+	switch {
+	case ns == 0:
+		return "0s"
+	case ns < microsecond:
+		return fmt.Sprintf("%s%dns", sign, ns)
+	case ns < millisecond:
+		return fmt.Sprintf("%s%dµs", sign, ns/microsecond)
+	case ns < second:
+		return fmt.Sprintf("%s%dms", sign, ns/millisecond)
+	}
+
+	units := []struct {
+		size   uint64
+		suffix string
+	}{
+		{uint64(24 * time.Hour), "d"},
+		{uint64(time.Hour), "h"},
+		{uint64(time.Minute), "m"},
+		{second, "s"},
+	}
+
+	parts := make([]string, 0, len(units))
+	for _, unit := range units {
+		if count := ns / unit.size; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", count, unit.suffix))
+			ns -= count * unit.size
+		}
+	}
+	return sign + strings.Join(parts, " ")
+}
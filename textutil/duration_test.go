@@ -0,0 +1,43 @@
+// Package textutil contains tests for the duration humanizer
+package textutil
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestHumanizeDuration verifies sub-second, multi-unit and multi-day output
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{0, "0s"},
+		{15 * time.Nanosecond, "15ns"},
+		{40 * time.Microsecond, "40µs"},
+		{250 * time.Millisecond, "250ms"},
+		{999 * time.Millisecond, "999ms"},
+		{time.Second, "1s"},
+		{45 * time.Second, "45s"},
+		{1500 * time.Millisecond, "1s"}, // Fractional seconds are dropped
+		{2*time.Hour + 3*time.Minute, "2h 3m"},
+		{time.Hour + 5*time.Second, "1h 5s"}, // Zero minutes omitted
+		{90 * time.Minute, "1h 30m"},
+		{3*24*time.Hour + 4*time.Hour, "3d 4h"},
+		{2*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second, "2d 3h 4m 5s"},
+		{400 * 24 * time.Hour, "400d"},
+		{-90 * time.Second, "-1m 30s"},
+		{-5 * time.Millisecond, "-5ms"},
+		{math.MaxInt64, "106751d 23h 47m 16s"},
+		{math.MinInt64, "-106751d 23h 47m 16s"}, // -d overflows as an int64
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			if got := HumanizeDuration(tt.input); got != tt.expected {
+				t.Errorf("HumanizeDuration(%v) = %q; want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
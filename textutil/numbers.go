@@ -0,0 +1,59 @@
+// Package textutil - Converting between strings and number slices
+package textutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseInts splits s on sep and parses each token as a base-10 int
+// Whitespace around tokens is ignored, so "1, 2, 3" with sep "," works.
+// An empty sep splits on runs of whitespace instead, and an input that
+// is empty (or only whitespace) gives an empty slice
+// If a token can't be parsed, the error names the token and its
+// 1-based position, and wraps the strconv error so errors.Is can check
+// for strconv.ErrSyntax or strconv.ErrRange
+func ParseInts(s string, sep string) ([]int, error) {
+	if strings.TrimSpace(s) == "" {
+		return []int{}, nil
+	}
+
+	var tokens []string
+	if sep == "" {
+		tokens = strings.Fields(s)
+	} else {
+		tokens = strings.Split(s, sep)
+	}
+
+	nums := make([]int, 0, len(tokens))
+	for i, token := range tokens {
+		token = strings.TrimSpace(token)
+		n, err := strconv.Atoi(token)
+		if err != nil {
+			return nil, fmt.Errorf("parse ints: token %d (%q): %w", i+1, token, err)
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+// JoinInts formats nums in base 10 and joins them with sep, the inverse
+// of ParseInts: JoinInts([1 2 3], ", ") is "1, 2, 3"
+func JoinInts(nums []int, sep string) string {
+	return JoinFunc(nums, sep, strconv.Itoa)
+}
+
+// JoinFunc formats each element of s with fn and joins the results with
+// sep. It generalizes strings.Join to slices of any type
+// An empty slice gives an empty string
+func JoinFunc[T any](s []T, sep string, fn func(T) string) string {
+	var b strings.Builder
+	for i, item := range s {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(fn(item))
+	}
+	return b.String()
+}
@@ -0,0 +1,122 @@
+// Package textutil contains tests for the number slice helpers
+package textutil
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestParseInts verifies well-formed input is parsed
+func TestParseInts(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		sep      string
+		expected []int
+	}{
+		{"comma separated", "1,2,3", ",", []int{1, 2, 3}},
+		{"surrounding whitespace", "  4 ,5,  -6  ", ",", []int{4, 5, -6}},
+		{"multi-character separator", "10 | 20 | 30", "|", []int{10, 20, 30}},
+		{"single value", "42", ",", []int{42}},
+		{"whitespace separated", " 7\t8\n 9 ", "", []int{7, 8, 9}},
+		{"empty input", "", ",", []int{}},
+		{"blank input", "   ", ",", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInts(tt.input, tt.sep)
+			if err != nil {
+				t.Fatalf("ParseInts(%q, %q) error = %v", tt.input, tt.sep, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseInts(%q, %q) = %v; want %v", tt.input, tt.sep, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestParseIntsErrors verifies the first bad token is reported
+func TestParseIntsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		errMsg  string
+		wantErr error
+	}{
+		{"not a number", "1,2,x,4", `token 3 ("x")`, strconv.ErrSyntax},
+		{"float", "1.5,2", `token 1 ("1.5")`, strconv.ErrSyntax},
+		{"empty token", "1,,3", `token 2 ("")`, strconv.ErrSyntax},
+		{"first of several bad tokens", "1,a,b", `token 2 ("a")`, strconv.ErrSyntax},
+		{"out of range", "99999999999999999999", `token 1`, strconv.ErrRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInts(tt.input, ",")
+			if err == nil {
+				t.Fatalf("ParseInts(%q) = %v; want an error", tt.input, got)
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("ParseInts(%q) error = %q; want it to contain %q", tt.input, err.Error(), tt.errMsg)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseInts(%q) error = %v; want it to wrap %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestJoinInts verifies ints are formatted and joined
+func TestJoinInts(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		sep      string
+		expected string
+	}{
+		{"empty", []int{}, ",", ""},
+		{"nil", nil, ",", ""},
+		{"single", []int{42}, ",", "42"},
+		{"multiple", []int{1, -2, 30}, ", ", "1, -2, 30"},
+		{"empty separator", []int{1, 2, 3}, "", "123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinInts(tt.input, tt.sep); got != tt.expected {
+				t.Errorf("JoinInts(%v, %q) = %q; want %q", tt.input, tt.sep, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestJoinIntsRoundTrip verifies ParseInts undoes JoinInts
+func TestJoinIntsRoundTrip(t *testing.T) {
+	nums := []int{5, 0, -17, 123456}
+	got, err := ParseInts(JoinInts(nums, ";"), ";")
+	if err != nil || !reflect.DeepEqual(got, nums) {
+		t.Errorf("ParseInts(JoinInts(%v)) = %v, %v; want %v, nil", nums, got, err, nums)
+	}
+}
+
+// TestJoinFunc verifies custom element formatting
+func TestJoinFunc(t *testing.T) {
+	prices := []float64{1.5, 20, 0.25}
+	got := JoinFunc(prices, " | ", func(p float64) string {
+		return "$" + strconv.FormatFloat(p, 'f', 2, 64)
+	})
+	if want := "$1.50 | $20.00 | $0.25"; got != want {
+		t.Errorf("JoinFunc(%v) = %q; want %q", prices, got, want)
+	}
+
+	if got := JoinFunc([]bool{true}, ",", strconv.FormatBool); got != "true" {
+		t.Errorf("JoinFunc([true]) = %q; want \"true\"", got)
+	}
+	if got := JoinFunc([]string{}, ",", strings.ToUpper); got != "" {
+		t.Errorf("JoinFunc(empty) = %q; want \"\"", got)
+	}
+}
@@ -0,0 +1,29 @@
+// Package textutil - Text progress bars
+package textutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderProgressBar draws a bar width characters wide followed by the
+// percentage done: RenderProgressBar(4, 8, 8) is "[####----] 50%"
+// current is clamped to [0, total], so overshooting or negative counts
+// still draw a sensible bar. A total of zero or less means there is
+// nothing to do, which counts as complete (like ReportProgress in the
+// collections package). A negative width is treated as 0
+// Both the bar and the percentage round down, so 100% only shows once
+// the work is actually finished
+func RenderProgressBar(current, total, width int) string {
+	width = max(width, 0)
+
+	filled, percent := width, 100
+	if total > 0 {
+		current = min(max(current, 0), total)
+		filled = current * width / total
+		percent = current * 100 / total
+	}
+
+	return fmt.Sprintf("[%s%s] %d%%",
+		strings.Repeat("#", filled), strings.Repeat("-", width-filled), percent)
+}
@@ -0,0 +1,35 @@
+// Package textutil contains tests for the progress bar renderer
+package textutil
+
+import "testing"
+
+// TestRenderProgressBar verifies bars at key points and out of range
+func TestRenderProgressBar(t *testing.T) {
+	tests := []struct {
+		name                  string
+		current, total, width int
+		expected              string
+	}{
+		{"0%", 0, 8, 8, "[--------] 0%"},
+		{"50%", 4, 8, 8, "[####----] 50%"},
+		{"100%", 8, 8, 8, "[########] 100%"},
+		{"rounds down", 2, 3, 10, "[######----] 66%"},
+		{"almost done is not 100%", 999, 1000, 10, "[#########-] 99%"},
+		{"width differs from total", 1, 4, 20, "[#####---------------] 25%"},
+		{"current above total", 12, 8, 8, "[########] 100%"},
+		{"negative current", -3, 8, 8, "[--------] 0%"},
+		{"zero total", 0, 0, 5, "[#####] 100%"},
+		{"zero width", 1, 2, 0, "[] 50%"},
+		{"negative width", 1, 2, -4, "[] 50%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderProgressBar(tt.current, tt.total, tt.width)
+			if got != tt.expected {
+				t.Errorf("RenderProgressBar(%d, %d, %d) = %q; want %q",
+					tt.current, tt.total, tt.width, got, tt.expected)
+			}
+		})
+	}
+}
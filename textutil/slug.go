@@ -0,0 +1,55 @@
+// Package textutil - URL slug generation
+package textutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// transliterations maps common accented lowercase letters to ASCII
+var transliterations = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+	'ý': "y", 'ÿ': "y",
+	'ß': "ss",
+}
+
+// Slugify turns s into a URL-friendly slug: "Héllo, World!" becomes
+// "hello-world"
+// The text is lowercased, common accented letters are transliterated to
+// ASCII, and every run of other characters (spaces, punctuation, symbols,
+// and letters with no ASCII equivalent) becomes a single hyphen.
+// Leading and trailing hyphens are removed
+func Slugify(s string) string {
+	var builder strings.Builder
+	pendingHyphen := false // A separator was seen since the last letter
+
+	for _, r := range strings.ToLower(s) {
+		var part string
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			part = string(r)
+		case transliterations[r] != "":
+			part = transliterations[r]
+		default:
+			pendingHyphen = true
+			continue
+		}
+
+		// Only write a hyphen between words, never at the start
+		if pendingHyphen && builder.Len() > 0 {
+			builder.WriteByte('-')
+		}
+		pendingHyphen = false
+		builder.WriteString(part)
+	}
+
+	// Trailing separators are never written, since a hyphen is only
+	// added right before the next word
+	return builder.String()
+}
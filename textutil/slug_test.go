@@ -0,0 +1,32 @@
+// Package textutil contains tests for slug generation
+package textutil
+
+import "testing"
+
+// TestSlugify verifies spacing, punctuation and transliteration
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"simple", "Hello World", "hello-world"},
+		{"multiple spaces", "Hello    big   World", "hello-big-world"},
+		{"punctuation", "Go: Tips, Tricks & More!", "go-tips-tricks-more"},
+		{"leading and trailing", "  --Hello World--  ", "hello-world"},
+		{"accented", "Crème Brûlée à la Façon", "creme-brulee-a-la-facon"},
+		{"german", "Straße Über", "strasse-uber"},
+		{"digits", "Go 1.21 Release", "go-1-21-release"},
+		{"no ascii equivalent", "hello 世界 world", "hello-world"},
+		{"only punctuation", "!!!", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.input); got != tt.expected {
+				t.Errorf("Slugify(%q) = %q; want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
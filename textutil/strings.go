@@ -0,0 +1,110 @@
+// Package textutil - Unicode-aware string basics
+package textutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ReverseString reverses s character by character (rune by rune)
+// Reversing the bytes would scramble multi-byte UTF-8 characters such as
+// "é" or "世", so the string is converted to a []rune first. Swapping in
+// place from both ends is O(n), unlike building the result by prepending
+// one rune at a time, which copies the string on every step (O(n^2))
+// Note that characters built from several runes, such as a letter plus a
+// combining accent or flag emoji, are reversed rune by rune
+func ReverseString(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+// IsPalindrome reports whether s reads the same forwards and backwards,
+// ignoring case, spaces, and punctuation
+// Only letters and digits (as defined by the unicode package) are
+// compared, so "A man, a plan, a canal: Panama" is a palindrome
+// An empty string, or one with no letters or digits, counts as one
+func IsPalindrome(s string) bool {
+	// Normalize: keep lowercase letters and digits only
+	normalized := make([]rune, 0, len(s))
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			normalized = append(normalized, unicode.ToLower(r))
+		}
+	}
+
+	// Compare from both ends toward the middle
+	for i, j := 0, len(normalized)-1; i < j; i, j = i+1, j-1 {
+		if normalized[i] != normalized[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// RuneCount returns the number of characters (runes) in s
+// This is what people usually mean by "length": "héllo" has 5
+func RuneCount(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// ByteCount returns the number of bytes in s, which is what len(s) reports
+// Characters outside ASCII take 2 to 4 bytes in UTF-8, so "héllo" has 6
+func ByteCount(s string) int {
+	return len(s)
+}
+
+// CharStats returns both counts at once; they are equal only when s is
+// plain ASCII
+func CharStats(s string) (runes, bytes int) {
+	return RuneCount(s), ByteCount(s)
+}
+
+// smallWords are the short words TitleCase leaves lowercase, following
+// common English headline style
+var smallWords = map[string]bool{
+	"a": true, "an": true, "the": true,
+	"and": true, "but": true, "or": true, "nor": true,
+	"of": true, "in": true, "on": true, "at": true,
+	"to": true, "for": true, "by": true,
+}
+
+// TitleCase capitalizes the first letter of each word, except for small
+// words such as "a", "the", and "of", which stay lowercase unless they
+// start the string: "the lord of the rings" becomes "The Lord of the Rings"
+// The rest of each word is left as-is, so acronyms like "NASA" survive
+// Words are split with strings.Fields and rejoined with single spaces
+func TitleCase(s string) string {
+	words := strings.Fields(s)
+	for i, word := range words {
+		lower := strings.ToLower(word)
+		if i > 0 && smallWords[lower] {
+			words[i] = lower
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
+
+// Truncate shortens s to at most max characters (runes, not bytes)
+// When s is too long, it is cut and "…" is appended; the ellipsis counts
+// toward max, so the result is never longer than max characters
+// Working on runes guarantees a multi-byte character is never split
+// A max of zero or less returns an empty string
+func Truncate(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	// Leave room for the one-character ellipsis
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,159 @@
+// Package textutil contains tests for the Unicode-aware string basics
+package textutil
+
+import "testing"
+
+// TestReverseString verifies byte-for-byte output for multi-byte input
+func TestReverseString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"ascii", "hello", "olleh"},
+		{"cjk", "世界你好", "好你界世"},
+		{"accented", "café", "éfac"},
+		{"emoji", "go🚀!", "!🚀og"},
+		{"single rune", "é", "é"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReverseString(tt.input)
+			// String equality in Go compares the underlying bytes, so this
+			// also proves no UTF-8 sequence was split
+			if got != tt.expected {
+				t.Errorf("ReverseString(%q) = %q (% x); want %q (% x)",
+					tt.input, got, got, tt.expected, tt.expected)
+			}
+		})
+	}
+
+	// Reversing twice must give back the original
+	for _, s := range []string{"héllo 世界 🚀", "abc"} {
+		if got := ReverseString(ReverseString(s)); got != s {
+			t.Errorf("ReverseString(ReverseString(%q)) = %q", s, got)
+		}
+	}
+}
+
+// TestIsPalindrome verifies normalization of case and punctuation
+func TestIsPalindrome(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"A man, a plan, a canal: Panama", true},
+		{"racecar", true},
+		{"Was it a car or a cat I saw?", true},
+		{"No 'x' in Nixon", true},
+		{"hello", false},
+		{"palindrome", false},
+		{"Ésé", true},  // Unicode letters with accents
+		{"上海海上", true}, // CJK characters
+		{"上海", false},
+		{"12321", true},
+		{"", true},
+		{"!!!", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsPalindrome(tt.input); got != tt.expected {
+				t.Errorf("IsPalindrome(%q) = %v; want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCharStats verifies rune and byte counts diverge for multi-byte text
+func TestCharStats(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedRunes int
+		expectedBytes int
+	}{
+		{"ascii", "hello", 5, 5},
+		{"accented", "héllo", 5, 6}, // é is 2 bytes
+		{"cjk", "世界", 2, 6},         // Each CJK character is 3 bytes
+		{"emoji", "🚀", 1, 4},        // Emoji are 4 bytes
+		{"empty", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RuneCount(tt.input); got != tt.expectedRunes {
+				t.Errorf("RuneCount(%q) = %d; want %d", tt.input, got, tt.expectedRunes)
+			}
+			if got := ByteCount(tt.input); got != tt.expectedBytes {
+				t.Errorf("ByteCount(%q) = %d; want %d", tt.input, got, tt.expectedBytes)
+			}
+			runes, bytes := CharStats(tt.input)
+			if runes != tt.expectedRunes || bytes != tt.expectedBytes {
+				t.Errorf("CharStats(%q) = (%d, %d); want (%d, %d)",
+					tt.input, runes, bytes, tt.expectedRunes, tt.expectedBytes)
+			}
+		})
+	}
+}
+
+// TestTitleCase verifies capitalization and small-word handling
+func TestTitleCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"multi-word", "the lord of the rings", "The Lord of the Rings"},
+		{"leading small word", "a tale of two cities", "A Tale of Two Cities"},
+		{"small words lowercased", "War And Peace", "War and Peace"},
+		{"acronym kept", "working at NASA", "Working at NASA"},
+		{"single word", "golang", "Golang"},
+		{"single small word", "the", "The"},
+		{"extra spaces", "  go   in  action ", "Go in Action"},
+		{"unicode", "élan vital", "Élan Vital"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TitleCase(tt.input); got != tt.expected {
+				t.Errorf("TitleCase(%q) = %q; want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestTruncate verifies rune-based truncation with an ellipsis
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		expected string
+	}{
+		{"shorter than max", "hello", 10, "hello"},
+		{"exactly max", "hello", 5, "hello"},
+		{"longer than max", "hello world", 8, "hello w…"},
+		{"max one", "hello", 1, "…"},
+		{"max zero", "hello", 0, ""},
+		{"unicode not split", "世界你好世界", 4, "世界你…"},
+		{"emoji", "🚀🚀🚀🚀", 3, "🚀🚀…"},
+		{"empty", "", 3, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.input, tt.max)
+			if got != tt.expected {
+				t.Errorf("Truncate(%q, %d) = %q; want %q", tt.input, tt.max, got, tt.expected)
+			}
+			if tt.max > 0 && RuneCount(got) > tt.max {
+				t.Errorf("Truncate(%q, %d) has %d runes; want at most %d",
+					tt.input, tt.max, RuneCount(got), tt.max)
+			}
+		})
+	}
+}
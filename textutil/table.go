@@ -0,0 +1,74 @@
+// Package textutil - Rendering rows as a bordered ASCII table
+package textutil
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatTable renders headers and rows as an ASCII table with borders:
+//
+//	+-------+-----+
+//	| Name  | Qty |
+//	+-------+-----+
+//	| apple | 10  |
+//	| fig   | 2   |
+//	+-------+-----+
+//
+// Each column is as wide as its longest cell, counted in runes so
+// accented letters line up. Rows with fewer cells than headers are
+// padded with blanks, and longer rows add columns with a blank header.
+// collections.FormatTable writes a plainer layout to an io.Writer; this
+// version returns a string, which suits tests and log messages
+func FormatTable(headers []string, rows [][]string) string {
+	widths := make([]int, len(headers))
+	measure := func(cells []string) {
+		for i, cell := range cells {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	measure(headers)
+	for _, row := range rows {
+		measure(row)
+	}
+
+	// The border line is the same above, below, and under the header
+	var border strings.Builder
+	border.WriteString("+")
+	for _, width := range widths {
+		border.WriteString(strings.Repeat("-", width+2))
+		border.WriteString("+")
+	}
+	border.WriteString("\n")
+
+	var b strings.Builder
+	b.WriteString(border.String())
+	writeTableRow(&b, headers, widths)
+	b.WriteString(border.String())
+	for _, row := range rows {
+		writeTableRow(&b, row, widths)
+	}
+	if len(rows) > 0 {
+		b.WriteString(border.String())
+	}
+	return b.String()
+}
+
+// writeTableRow writes one "| a | b |" row, padding missing cells
+func writeTableRow(b *strings.Builder, cells []string, widths []int) {
+	b.WriteString("|")
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		b.WriteString(" ")
+		b.WriteString(cell)
+		b.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(cell)))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
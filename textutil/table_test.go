@@ -0,0 +1,102 @@
+// Package textutil contains tests for the ASCII table formatter
+package textutil
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// TestFormatTable verifies the exact output for small tables
+func TestFormatTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  []string
+		rows     [][]string
+		expected string
+	}{
+		{
+			name:    "small table",
+			headers: []string{"Name", "Qty"},
+			rows:    [][]string{{"apple", "10"}, {"fig", "2"}},
+			expected: "" +
+				"+-------+-----+\n" +
+				"| Name  | Qty |\n" +
+				"+-------+-----+\n" +
+				"| apple | 10  |\n" +
+				"| fig   | 2   |\n" +
+				"+-------+-----+\n",
+		},
+		{
+			name:    "short row padded with blanks",
+			headers: []string{"A", "B", "C"},
+			rows:    [][]string{{"1"}, {"1", "2", "3"}},
+			expected: "" +
+				"+---+---+---+\n" +
+				"| A | B | C |\n" +
+				"+---+---+---+\n" +
+				"| 1 |   |   |\n" +
+				"| 1 | 2 | 3 |\n" +
+				"+---+---+---+\n",
+		},
+		{
+			name:    "long row adds a column",
+			headers: []string{"A"},
+			rows:    [][]string{{"1", "extra"}},
+			expected: "" +
+				"+---+-------+\n" +
+				"| A |       |\n" +
+				"+---+-------+\n" +
+				"| 1 | extra |\n" +
+				"+---+-------+\n",
+		},
+		{
+			name:    "headers only",
+			headers: []string{"Empty"},
+			rows:    nil,
+			expected: "" +
+				"+-------+\n" +
+				"| Empty |\n" +
+				"+-------+\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTable(tt.headers, tt.rows); got != tt.expected {
+				t.Errorf("FormatTable() =\n%s\nwant\n%s", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatTableAlignment verifies every line has the same width and
+// the column borders line up, even with multi-byte characters
+func TestFormatTableAlignment(t *testing.T) {
+	got := FormatTable(
+		[]string{"City", "Country", "Population"},
+		[][]string{
+			{"São Paulo", "Brazil", "12325232"},
+			{"Zürich", "Switzerland", "421878"},
+			{"Rome", "Italy", "2873000"},
+		},
+	)
+
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	width := utf8.RuneCountInString(lines[0])
+	for i, line := range lines {
+		if n := utf8.RuneCountInString(line); n != width {
+			t.Errorf("line %d has %d runes; want %d:\n%s", i, n, width, got)
+		}
+	}
+
+	// Border positions in the first data row match the border line
+	border := []rune(lines[0])
+	row := []rune(lines[3])
+	for i, r := range border {
+		if (r == '+') != (row[i] == '|') {
+			t.Errorf("column separator mismatch at position %d:\n%s", i, got)
+			break
+		}
+	}
+}
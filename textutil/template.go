@@ -0,0 +1,41 @@
+// Package textutil - Simple {{key}} string interpolation
+package textutil
+
+import "strings"
+
+// Interpolate replaces every {{key}} placeholder in template with the
+// value of key in vars. Spaces around the key are ignored, so {{ name }}
+// works too. Like the "default values" pattern in MapPatterns, lookups
+// use the two-value map form: placeholders whose key is missing from
+// vars are left in the output unchanged, which makes typos easy to spot
+// Replaced values are not scanned again, so a value containing "{{x}}"
+// is inserted literally
+func Interpolate(template string, vars map[string]string) string {
+	var builder strings.Builder
+	builder.Grow(len(template))
+
+	rest := template
+	for {
+		start := strings.Index(rest, "{{")
+		if start == -1 {
+			break
+		}
+		end := strings.Index(rest[start+2:], "}}")
+		if end == -1 {
+			break // An unclosed "{{" is plain text
+		}
+		end += start + 2 // Make end relative to rest
+
+		builder.WriteString(rest[:start])
+		key := strings.TrimSpace(rest[start+2 : end])
+		if value, ok := vars[key]; ok {
+			builder.WriteString(value)
+		} else {
+			builder.WriteString(rest[start : end+2]) // Keep the placeholder
+		}
+		rest = rest[end+2:]
+	}
+
+	builder.WriteString(rest)
+	return builder.String()
+}
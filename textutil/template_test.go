@@ -0,0 +1,46 @@
+// Package textutil contains tests for string interpolation
+package textutil
+
+import "testing"
+
+// TestInterpolate verifies placeholder replacement
+func TestInterpolate(t *testing.T) {
+	vars := map[string]string{
+		"name":  "Gopher",
+		"lang":  "Go",
+		"empty": "",
+		"nest":  "{{name}}",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"present keys", "Hello, {{name}}! Welcome to {{lang}}.", "Hello, Gopher! Welcome to Go."},
+		{"missing key kept", "Hi {{name}}, your id is {{id}}", "Hi Gopher, your id is {{id}}"},
+		{"repeated placeholder", "{{lang}} {{lang}} {{lang}}!", "Go Go Go!"},
+		{"adjacent placeholders", "{{name}}{{lang}}", "GopherGo"},
+		{"spaces around key", "{{ name }}", "Gopher"},
+		{"empty value", "[{{empty}}]", "[]"},
+		{"value not rescanned", "{{nest}}", "{{name}}"},
+		{"unclosed placeholder", "Hello {{name", "Hello {{name"},
+		{"no placeholders", "plain text", "plain text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Interpolate(tt.template, vars); got != tt.expected {
+				t.Errorf("Interpolate(%q) = %q; want %q", tt.template, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestInterpolateNilMap verifies a nil map leaves every placeholder intact
+func TestInterpolateNilMap(t *testing.T) {
+	template := "Hello {{name}}"
+	if got := Interpolate(template, nil); got != template {
+		t.Errorf("Interpolate(%q, nil) = %q; want %q", template, got, template)
+	}
+}
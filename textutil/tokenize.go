@@ -0,0 +1,76 @@
+// Package textutil provides small, reusable string helpers that build on
+// the string iteration lessons in the loops and basics packages.
+// Functions here are Unicode-aware: they work on runes, not bytes.
+package textutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenize splits input on whitespace, like strings.Fields, but keeps
+// quoted substrings together as a single token
+// Both double quotes and single quotes are supported, and the quote
+// characters themselves are removed:
+//
+//	Tokenize(`say "hello world" to 'the team'`)
+//	// ["say", "hello world", "to", "the team"]
+//
+// A quote in the middle of a word joins with it (`a"b c"` is `ab c`),
+// and an empty pair of quotes produces an empty token
+// Fallback for unbalanced quotes: a quote with no matching closing quote
+// is kept as an ordinary character, so `it's fine` gives ["it's", "fine"]
+func Tokenize(input string) []string {
+	tokens := []string{}
+	runes := []rune(input)
+
+	var current strings.Builder
+	inToken := false // Tracks tokens that are empty but present, like ""
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			// Whitespace ends the current token, if any
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+
+		case r == '"' || r == '\'':
+			// Look for the matching closing quote
+			closing := indexRune(runes, i+1, r)
+			if closing == -1 {
+				// Unbalanced - treat the quote as a literal character
+				current.WriteRune(r)
+				inToken = true
+				continue
+			}
+			current.WriteString(string(runes[i+1 : closing]))
+			inToken = true
+			i = closing // Continue after the closing quote
+
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// indexRune returns the index of the first target in runes at or after
+// start, or -1 if there is none
+func indexRune(runes []rune, start int, target rune) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == target {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,36 @@
+// Package textutil contains tests for the quote-aware tokenizer
+package textutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestTokenize verifies splitting with and without quotes
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"simple", "the quick  brown\tfox", []string{"the", "quick", "brown", "fox"}},
+		{"double quotes", `say "hello world" now`, []string{"say", "hello world", "now"}},
+		{"single quotes", `name='John Smith' age=30`, []string{"name=John Smith", "age=30"}},
+		{"mixed quotes", `"it's here" 'say "hi"'`, []string{"it's here", `say "hi"`}},
+		{"empty quotes", `a "" b`, []string{"a", "", "b"}},
+		{"unbalanced quote", `it's fine`, []string{"it's", "fine"}},
+		{"unbalanced double quote", `open "ended text`, []string{"open", `"ended`, "text"}},
+		{"unicode", `café "naïve résumé"`, []string{"café", "naïve résumé"}},
+		{"empty input", "", []string{}},
+		{"only whitespace", "   ", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tokenize(tt.input)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Tokenize(%q) = %q; want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}